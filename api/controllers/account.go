@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type DeleteAccountInput struct {
+	Password string `json:"password" binding:"required" example:"123456789"`
+}
+
+// ExportAccount godoc
+// @Summary ExportAccount
+// @Security ApiKeyAuth
+// @Tags account-controller
+// @ID export-account
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} helpers.AccountExport
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /account/export [get]
+func ExportAccount(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	var user models.User
+	result := initializers.DB.First(&user, authUser.ID)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	export, err := helpers.ExportAccount(&user)
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot export account data")
+		return
+	}
+
+	filename := fmt.Sprintf("ozinshe-account-%d.json", user.ID)
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.IndentedJSON(http.StatusOK, export)
+}
+
+// DeleteAccount godoc
+// @Summary DeleteAccount
+// @Description Schedules the account for deletion after the grace period. The personal data of
+// @Description the account is then deleted; the user row is only anonymised and soft-deleted,
+// @Description as the audit log still refers to it.
+// @Security ApiKeyAuth
+// @Tags account-controller
+// @ID delete-account
+// @Accept  json
+// @Produce  json
+// @Param input body DeleteAccountInput true "password"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /account/delete [post]
+func DeleteAccount(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	var userInput DeleteAccountInput
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var user models.User
+	result := initializers.DB.First(&user, authUser.ID)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(userInput.Password)); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid password")
+		return
+	}
+
+	deleteAt, err := helpers.ScheduleAccountDeletion(&user)
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot delete account")
+		return
+	}
+
+	err = helpers.SendEmail(user.Email, "Account Deletion", "account_deletion", gin.H{
+		"DeleteAt": deleteAt.Format("2 January 2006 15:04 MST"),
+	})
+	if err != nil {
+		log.Println("failed to send account deletion email:", err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "account will be deleted",
+		"deleteAt": deleteAt.Format(time.RFC3339),
+	})
+}
+
+// CancelAccountDeletion godoc
+// @Summary CancelAccountDeletion
+// @Security ApiKeyAuth
+// @Tags account-controller
+// @ID cancel-account-deletion
+// @Accept  json
+// @Produce  json
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /account/delete/cancel [post]
+func CancelAccountDeletion(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	var user models.User
+	result := initializers.DB.First(&user, authUser.ID)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if !user.IsDeletionScheduled() {
+		NewErrorResponse(c, http.StatusBadRequest, "account deletion is not scheduled")
+		return
+	}
+
+	if err := helpers.CancelAccountDeletion(&user); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot cancel account deletion")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "account deletion cancelled",
+	})
+}
@@ -0,0 +1,194 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type CreateAPIKeyInput struct {
+	Name   string   `json:"name" binding:"required,max=100" example:"content import"`
+	Scopes []string `json:"scopes" binding:"required,min=1" example:"catalog:read,movie:write"`
+	// ExpiresAt is an RFC 3339 time; without it the key does not expire.
+	ExpiresAt *time.Time `json:"expiresAt" example:"2030-01-01T00:00:00Z"`
+}
+
+type APIKeyResponse struct {
+	ID         uint
+	Name       string
+	KeyPrefix  string
+	Scopes     []string
+	OwnerID    uint
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+type CreatedAPIKeyResponse struct {
+	APIKeyResponse
+	// Key is shown only once, when the key is created.
+	Key string
+}
+
+func newAPIKeyResponse(apiKey *models.APIKey) APIKeyResponse {
+	return APIKeyResponse{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		KeyPrefix:  apiKey.KeyPrefix,
+		Scopes:     apiKey.Scopes,
+		OwnerID:    apiKey.OwnerID,
+		CreatedAt:  apiKey.CreatedAt,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+	}
+}
+
+// GetAPIKeys godoc
+// @Summary GetAPIKeys
+// @Security ApiKeyAuth
+// @Tags admin-api-key-controller
+// @ID get-api-keys
+// @Accept  json
+// @Produce  json
+// @Success 200 {array} APIKeyResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/apikeys [get]
+func GetAPIKeys(c *gin.Context) {
+	var apiKeys []models.APIKey
+	if err := initializers.DB.Order("id").Find(&apiKeys).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get api keys")
+		return
+	}
+
+	response := []APIKeyResponse{}
+	for i := range apiKeys {
+		response = append(response, newAPIKeyResponse(&apiKeys[i]))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateAPIKey godoc
+// @Summary CreateAPIKey
+// @Security ApiKeyAuth
+// @Tags admin-api-key-controller
+// @ID create-api-key
+// @Accept  json
+// @Produce  json
+// @Param input body CreateAPIKeyInput true "name, scopes and expiry"
+// @Success 200 {object} CreatedAPIKeyResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/apikeys [post]
+func CreateAPIKey(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	if authUser.APIKeyID != 0 {
+		NewErrorResponse(c, http.StatusForbidden, "api keys cannot create api keys")
+		return
+	}
+
+	var userInput CreateAPIKeyInput
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	if userInput.ExpiresAt != nil && !userInput.ExpiresAt.After(time.Now()) {
+		NewErrorResponse(c, http.StatusBadRequest, "expiry must be in the future")
+		return
+	}
+
+	var apiKey *models.APIKey
+	var key string
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		apiKey, key, err = helpers.CreateAPIKey(tx, authUser, userInput.Name, userInput.Scopes, userInput.ExpiresAt)
+		if err != nil {
+			return err
+		}
+
+		// the snapshot leaves out the key hash
+		return helpers.WriteAudit(tx, c, models.AuditActionCreate, models.AuditEntityAPIKey, apiKey.ID, nil, newAPIKeyResponse(apiKey))
+	})
+	if errors.Is(err, helpers.ErrInvalidScope) {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid scope")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot create api key")
+		return
+	}
+
+	c.JSON(http.StatusOK, CreatedAPIKeyResponse{
+		APIKeyResponse: newAPIKeyResponse(apiKey),
+		Key:            key,
+	})
+}
+
+// RevokeAPIKey godoc
+// @Summary RevokeAPIKey
+// @Security ApiKeyAuth
+// @Tags admin-api-key-controller
+// @ID revoke-api-key
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "api key id"
+// @Success 200 {object} APIKeyResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/apikeys/{id} [delete]
+func RevokeAPIKey(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid api key id")
+		return
+	}
+
+	var apiKey *models.APIKey
+	err = initializers.DB.Transaction(func(tx *gorm.DB) error {
+		var before models.APIKey
+		if err := tx.First(&before, id).Error; err != nil {
+			return helpers.ErrAPIKeyNotFound
+		}
+
+		var err error
+		apiKey, err = helpers.RevokeAPIKey(tx, uint(id))
+		if err != nil || before.RevokedAt != nil {
+			return err
+		}
+
+		if err := tx.First(apiKey, apiKey.ID).Error; err != nil {
+			return err
+		}
+
+		// the snapshots leave out the key hash
+		return helpers.WriteAudit(tx, c, models.AuditActionUpdate, models.AuditEntityAPIKey, apiKey.ID,
+			newAPIKeyResponse(&before), newAPIKeyResponse(apiKey))
+	})
+	if errors.Is(err, helpers.ErrAPIKeyNotFound) {
+		NewErrorResponse(c, http.StatusNotFound, "api key not found")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot revoke api key")
+		return
+	}
+
+	c.JSON(http.StatusOK, newAPIKeyResponse(apiKey))
+}
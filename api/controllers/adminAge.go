@@ -4,14 +4,17 @@ import (
 	"net/http"
 
 	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
 	"github.com/diana-gemini/ozinshe/internal/models"
 	"github.com/diana-gemini/ozinshe/internal/validations"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 type NewAgeCategory struct {
 	AgeCategoryName string `json:"ageCategoryName" binding:"required,min=2" example:"0-13"`
+	MinimumAge      *int   `json:"minimumAge" binding:"required,min=0,max=21" example:"0"`
 }
 
 // CreateAgeCategory godoc
@@ -42,11 +45,18 @@ func CreateAgeCategory(c *gin.Context) {
 
 	ageCategory := models.AgeCategory{
 		AgeCategoryName: userInput.AgeCategoryName,
+		MinimumAge:      userInput.MinimumAge,
 	}
 
-	result := initializers.DB.Create(&ageCategory)
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&ageCategory).Error; err != nil {
+			return err
+		}
 
-	if result.Error != nil {
+		return helpers.WriteAudit(tx, c, models.AuditActionCreate, models.AuditEntityAgeCategory, ageCategory.ID, nil, ageCategory)
+	})
+
+	if err != nil {
 		NewErrorResponse(c, http.StatusInternalServerError, "cannot create age category")
 		return
 	}
@@ -124,11 +134,24 @@ func UpdateAgeCategory(c *gin.Context) {
 
 	updateAgeCategory := models.AgeCategory{
 		AgeCategoryName: userInput.AgeCategoryName,
+		MinimumAge:      userInput.MinimumAge,
 	}
 
-	result = initializers.DB.Model(&ageCategory).Updates(&updateAgeCategory)
+	before := ageCategory
 
-	if result.Error != nil {
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&ageCategory).Updates(&updateAgeCategory).Error; err != nil {
+			return err
+		}
+
+		if err := tx.First(&ageCategory, ageCategory.ID).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionUpdate, models.AuditEntityAgeCategory, ageCategory.ID, before, ageCategory)
+	})
+
+	if err != nil {
 		NewErrorResponse(c, http.StatusInternalServerError, "cannot update age category")
 		return
 	}
@@ -161,7 +184,17 @@ func DeleteAgeCategory(c *gin.Context) {
 		return
 	}
 
-	err := initializers.DB.Delete(&ageCategory).Error
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&ageCategory).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("age_category_id = ?", ageCategory.ID).Delete(&models.AgeCategoryTranslation{}).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionDelete, models.AuditEntityAgeCategory, ageCategory.ID, ageCategory, nil)
+	})
 	if err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "cannot delete age category")
 		return
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AuditLogList struct {
+	AuditLogs []models.AuditLog
+	Page      int
+	Limit     int
+	Total     int64
+}
+
+// GetAuditLogs godoc
+// @Summary GetAuditLogs
+// @Security ApiKeyAuth
+// @Tags admin-audit-controller
+// @ID get-audit-logs
+// @Accept  json
+// @Produce  json
+// @Param page query integer false "page, starting from 1"
+// @Param limit query integer false "entries per page"
+// @Param entitytype query string false "category, type, age_category, movie, season, episode, keyword, person, credit, movie_translation, category_translation, type_translation, age_category_translation or api_key"
+// @Param entityid query integer false "entity id"
+// @Param actorid query integer false "id of the admin who made the change"
+// @Param action query string false "create, update or delete"
+// @Param from query string false "changed at or after, RFC 3339"
+// @Param to query string false "changed before, RFC 3339"
+// @Success 200 {object} AuditLogList
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/audit [get]
+func GetAuditLogs(c *gin.Context) {
+	page, limit, ok := parsePagination(c)
+	if !ok {
+		return
+	}
+
+	query := initializers.DB.Model(&models.AuditLog{})
+
+	if entityType := c.Query("entitytype"); entityType != "" {
+		query = query.Where("entity_type = ?", entityType)
+	}
+
+	if value := c.Query("entityid"); value != "" {
+		entityID, err := strconv.Atoi(value)
+		if err != nil {
+			NewErrorResponse(c, http.StatusBadRequest, "invalid entityid")
+			return
+		}
+		query = query.Where("entity_id = ?", entityID)
+	}
+
+	if value := c.Query("actorid"); value != "" {
+		actorID, err := strconv.Atoi(value)
+		if err != nil {
+			NewErrorResponse(c, http.StatusBadRequest, "invalid actorid")
+			return
+		}
+		query = query.Where("actor_id = ?", actorID)
+	}
+
+	if action := c.Query("action"); action != "" {
+		query = query.Where("action = ?", action)
+	}
+
+	if value := c.Query("from"); value != "" {
+		from, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			NewErrorResponse(c, http.StatusBadRequest, "invalid from time")
+			return
+		}
+		query = query.Where("created_at >= ?", from)
+	}
+
+	if value := c.Query("to"); value != "" {
+		to, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			NewErrorResponse(c, http.StatusBadRequest, "invalid to time")
+			return
+		}
+		query = query.Where("created_at < ?", to)
+	}
+
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get audit log")
+		return
+	}
+
+	auditLogs := []models.AuditLog{}
+	err := query.Order("created_at DESC, id DESC").Offset((page - 1) * limit).Limit(limit).Find(&auditLogs).Error
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get audit log")
+		return
+	}
+
+	c.JSON(http.StatusOK, AuditLogList{
+		AuditLogs: auditLogs,
+		Page:      page,
+		Limit:     limit,
+		Total:     total,
+	})
+}
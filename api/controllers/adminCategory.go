@@ -4,10 +4,12 @@ import (
 	"net/http"
 
 	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
 	"github.com/diana-gemini/ozinshe/internal/models"
 	"github.com/diana-gemini/ozinshe/internal/validations"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 type NewCategory struct {
@@ -44,9 +46,15 @@ func CreateCategory(c *gin.Context) {
 		CategoryName: userInput.CategoryName,
 	}
 
-	result := initializers.DB.Create(&category)
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&category).Error; err != nil {
+			return err
+		}
 
-	if result.Error != nil {
+		return helpers.WriteAudit(tx, c, models.AuditActionCreate, models.AuditEntityCategory, category.ID, nil, category)
+	})
+
+	if err != nil {
 		NewErrorResponse(c, http.StatusInternalServerError, "cannot create category")
 		return
 	}
@@ -126,9 +134,21 @@ func UpdateCategory(c *gin.Context) {
 		CategoryName: userInput.CategoryName,
 	}
 
-	result = initializers.DB.Model(&category).Updates(&updateCategory)
+	before := category
 
-	if result.Error != nil {
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&category).Updates(&updateCategory).Error; err != nil {
+			return err
+		}
+
+		if err := tx.First(&category, category.ID).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionUpdate, models.AuditEntityCategory, category.ID, before, category)
+	})
+
+	if err != nil {
 		NewErrorResponse(c, http.StatusInternalServerError, "cannot update category")
 		return
 	}
@@ -161,7 +181,17 @@ func DeleteCategory(c *gin.Context) {
 		return
 	}
 
-	err := initializers.DB.Delete(&category).Error
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&category).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("category_id = ?", category.ID).Delete(&models.CategoryTranslation{}).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionDelete, models.AuditEntityCategory, category.ID, category, nil)
+	})
 	if err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "cannot delete category")
 		return
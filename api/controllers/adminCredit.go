@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+	"github.com/diana-gemini/ozinshe/internal/validations"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type NewCredit struct {
+	PersonID uint   `json:"personId" binding:"required" example:"1"`
+	Role     string `json:"role" binding:"required,oneof=director writer producer actor" example:"actor"`
+	// Character is the part an actor plays; it is ignored for other roles.
+	Character string `json:"character" binding:"max=200" example:"Alucard"`
+	Position  int    `json:"position" binding:"min=0" example:"0"`
+}
+
+// newCreditFromInput validates the input and turns it into a credit of the
+// movie. It writes the error response itself and reports false on failure.
+func newCreditFromInput(c *gin.Context, movieID uint) (models.Credit, bool) {
+	var userInput NewCredit
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return models.Credit{}, false
+	}
+
+	if !validations.IsExistValue("people", "id", userInput.PersonID) {
+		NewErrorResponse(c, http.StatusBadRequest, "person does not exist")
+		return models.Credit{}, false
+	}
+
+	credit := models.Credit{
+		MovieID:  movieID,
+		PersonID: userInput.PersonID,
+		Role:     userInput.Role,
+		Position: userInput.Position,
+	}
+	if credit.Role == models.CreditRoleActor {
+		credit.Character = userInput.Character
+	}
+
+	return credit, true
+}
+
+// CreateCredit godoc
+// @Summary CreateCredit
+// @Security ApiKeyAuth
+// @Tags admin-movie-credit-controller
+// @ID create-credit
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "movieID"
+// @Param newCredit body NewCredit true "newCredit"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/credit/create [post]
+func CreateCredit(c *gin.Context) {
+	movieID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "cannot convert to int")
+		return
+	}
+
+	if !validations.IsExistValue("movies", "id", movieID) {
+		NewErrorResponse(c, http.StatusBadRequest, "cannot find movie")
+		return
+	}
+
+	credit, ok := newCreditFromInput(c, uint(movieID))
+	if !ok {
+		return
+	}
+
+	err = initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&credit).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionCreate, models.AuditEntityCredit, credit.ID, nil, credit)
+	})
+
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot create credit")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"credit": credit,
+	})
+}
+
+// UpdateCredit godoc
+// @Summary UpdateCredit
+// @Security ApiKeyAuth
+// @Tags admin-movie-credit-controller
+// @ID update-credit
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "movieID"
+// @Param creditid path integer true "creditID"
+// @Param newCredit body NewCredit true "newCredit"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/credit/{creditid}/update [put]
+func UpdateCredit(c *gin.Context) {
+	var credit models.Credit
+	result := initializers.DB.Where("movie_id = ? AND id = ?", c.Param("id"), c.Param("creditid")).First(&credit)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "credit not found")
+		return
+	}
+
+	updateCredit, ok := newCreditFromInput(c, credit.MovieID)
+	if !ok {
+		return
+	}
+
+	before := credit
+
+	credit.PersonID = updateCredit.PersonID
+	credit.Role = updateCredit.Role
+	credit.Character = updateCredit.Character
+	credit.Position = updateCredit.Position
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&credit).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionUpdate, models.AuditEntityCredit, credit.ID, before, credit)
+	})
+
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot update credit")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"credit": credit,
+	})
+}
+
+// DeleteCredit godoc
+// @Summary DeleteCredit
+// @Security ApiKeyAuth
+// @Tags admin-movie-credit-controller
+// @ID delete-credit
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "movieID"
+// @Param creditid path integer true "creditID"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/credit/{creditid}/delete [delete]
+func DeleteCredit(c *gin.Context) {
+	var credit models.Credit
+	result := initializers.DB.Where("movie_id = ? AND id = ?", c.Param("id"), c.Param("creditid")).First(&credit)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "credit not found")
+		return
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&credit).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionDelete, models.AuditEntityCredit, credit.ID, credit, nil)
+	})
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot delete credit")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "credit delete successfully",
+	})
+}
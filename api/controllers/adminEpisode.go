@@ -0,0 +1,217 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+	"github.com/diana-gemini/ozinshe/internal/validations"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type NewEpisode struct {
+	EpisodeNumber int    `form:"episodeNumber" binding:"required,min=1" example:"1"`
+	Link          string `form:"link" binding:"required" example:"video link"`
+	Title         string `form:"title" binding:"max=200" example:"Pilot"`
+	Description   string `form:"description" binding:"max=5000" example:"The first episode"`
+	Duration      int    `form:"duration" binding:"min=0,max=1440" example:"24"`
+	ReleaseDate   string `form:"releaseDate" binding:"omitempty,datetime=2006-01-02" example:"2024-01-15"`
+	// Position orders the episodes; it is the episode number when left out
+	Position int `form:"position" binding:"min=0" example:"1"`
+}
+
+// bindEpisode fills the video with the episode of the request. It writes the
+// error response itself and reports false on failure.
+func bindEpisode(c *gin.Context, video *models.Video) bool {
+	var userInput NewEpisode
+
+	if err := c.ShouldBind(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return false
+	}
+
+	var releaseDate *time.Time
+	if userInput.ReleaseDate != "" {
+		date, err := time.Parse(releaseDateLayout, userInput.ReleaseDate)
+		if err != nil {
+			NewErrorResponse(c, http.StatusBadRequest, "invalid release date")
+			return false
+		}
+		releaseDate = &date
+	}
+
+	thumbnailURL, ok := uploadOptionalImage(c, "thumbnail")
+	if !ok {
+		return false
+	}
+
+	video.EpisodeNumber = userInput.EpisodeNumber
+	video.Link = userInput.Link
+	video.Title = userInput.Title
+	video.Description = userInput.Description
+	video.Duration = userInput.Duration
+	video.ReleaseDate = releaseDate
+	video.Position = userInput.Position
+	if video.Position == 0 {
+		video.Position = userInput.EpisodeNumber
+	}
+	if thumbnailURL != "" {
+		video.Thumbnail = thumbnailURL
+	}
+
+	return true
+}
+
+// CreateEpisode godoc
+// @Summary CreateEpisode
+// @Security ApiKeyAuth
+// @Tags admin-movie-episode-controller
+// @ID create-episode
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path integer true "movieID"
+// @Param seasonnumber path integer true "season number"
+// @Param newEpisode formData NewEpisode true "newEpisode"
+// @Param thumbnail formData file false "thumbnail"
+// @Success 200 {object} EpisodeResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/season/{seasonnumber}/episode/create [post]
+func CreateEpisode(c *gin.Context) {
+	season, ok := findSeason(c)
+	if !ok {
+		return
+	}
+
+	video := models.Video{
+		SeasonID: season.ID,
+	}
+	if !bindEpisode(c, &video) {
+		return
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&video).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionCreate, models.AuditEntityEpisode, video.ID, nil, video)
+	})
+
+	if validations.IsDuplicateKey(err) {
+		NewErrorResponse(c, http.StatusConflict, "episode number already exists in the season")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot create episode")
+		return
+	}
+
+	c.JSON(http.StatusOK, newEpisodeResponse(&video))
+}
+
+// UpdateEpisode godoc
+// @Summary UpdateEpisode
+// @Security ApiKeyAuth
+// @Tags admin-movie-episode-controller
+// @ID update-episode
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path integer true "movieID"
+// @Param seasonnumber path integer true "season number"
+// @Param episodeid path integer true "episodeID"
+// @Param newEpisode formData NewEpisode true "newEpisode"
+// @Param thumbnail formData file false "new thumbnail; the old one is kept without it"
+// @Success 200 {object} EpisodeResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/season/{seasonnumber}/episode/{episodeid}/update [put]
+func UpdateEpisode(c *gin.Context) {
+	season, ok := findSeason(c)
+	if !ok {
+		return
+	}
+
+	var video models.Video
+	if err := initializers.DB.Where("season_id = ?", season.ID).First(&video, c.Param("episodeid")).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "episode not found")
+		return
+	}
+
+	before := video
+
+	if !bindEpisode(c, &video) {
+		return
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&video).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionUpdate, models.AuditEntityEpisode, video.ID, before, video)
+	})
+
+	if validations.IsDuplicateKey(err) {
+		NewErrorResponse(c, http.StatusConflict, "episode number already exists in the season")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot update episode")
+		return
+	}
+
+	c.JSON(http.StatusOK, newEpisodeResponse(&video))
+}
+
+// DeleteEpisode godoc
+// @Summary DeleteEpisode
+// @Security ApiKeyAuth
+// @Tags admin-movie-episode-controller
+// @ID delete-episode
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "movieID"
+// @Param seasonnumber path integer true "season number"
+// @Param episodeid path integer true "episodeID"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/season/{seasonnumber}/episode/{episodeid}/delete [delete]
+func DeleteEpisode(c *gin.Context) {
+	season, ok := findSeason(c)
+	if !ok {
+		return
+	}
+
+	var video models.Video
+	if err := initializers.DB.Where("season_id = ?", season.ID).First(&video, c.Param("episodeid")).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "episode not found")
+		return
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Delete(&video).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionDelete, models.AuditEntityEpisode, video.ID, video, nil)
+	})
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot delete episode")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "episode delete successfully",
+	})
+}
@@ -0,0 +1,171 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+	"github.com/diana-gemini/ozinshe/internal/validations"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type NewKeyword struct {
+	Name string `json:"name" binding:"required,max=50" example:"horror"`
+}
+
+// CreateKeyword godoc
+// @Summary CreateKeyword
+// @Security ApiKeyAuth
+// @Tags admin-keyword-controller
+// @ID create-keyword
+// @Accept  json
+// @Produce  json
+// @Param keyword body NewKeyword true "keyword"
+// @Success 200 {object} KeywordResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/keyword/create [post]
+func CreateKeyword(c *gin.Context) {
+	var userInput NewKeyword
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	name := helpers.NormalizeKeyword(userInput.Name)
+	if name == "" {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid keyword")
+		return
+	}
+
+	if validations.IsUniqueValue("keywords", "name", name) {
+		NewErrorResponse(c, http.StatusConflict, "keyword is already exist")
+		return
+	}
+
+	keyword := models.Keyword{
+		Name: name,
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&keyword).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionCreate, models.AuditEntityKeyword, keyword.ID, nil, keyword)
+	})
+
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot create keyword")
+		return
+	}
+
+	c.JSON(http.StatusOK, KeywordResponse{ID: keyword.ID, Name: keyword.Name})
+}
+
+// UpdateKeyword godoc
+// @Summary UpdateKeyword
+// @Security ApiKeyAuth
+// @Tags admin-keyword-controller
+// @ID update-keyword
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Param keyword body NewKeyword true "keyword"
+// @Success 200 {object} KeywordResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/keyword/{id}/update [put]
+func UpdateKeyword(c *gin.Context) {
+	id := c.Param("id")
+
+	var userInput NewKeyword
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	name := helpers.NormalizeKeyword(userInput.Name)
+	if name == "" {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid keyword")
+		return
+	}
+
+	var keyword models.Keyword
+	if err := initializers.DB.First(&keyword, id).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "keyword not found")
+		return
+	}
+
+	if name != keyword.Name && validations.IsUniqueValue("keywords", "name", name) {
+		NewErrorResponse(c, http.StatusConflict, "keyword is already exist")
+		return
+	}
+
+	before := keyword
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&keyword).Update("name", name).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionUpdate, models.AuditEntityKeyword, keyword.ID, before, keyword)
+	})
+
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot update keyword")
+		return
+	}
+
+	c.JSON(http.StatusOK, KeywordResponse{ID: keyword.ID, Name: keyword.Name})
+}
+
+// DeleteKeyword godoc
+// @Summary DeleteKeyword
+// @Security ApiKeyAuth
+// @Tags admin-keyword-controller
+// @ID delete-keyword
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/keyword/{id}/delete [delete]
+func DeleteKeyword(c *gin.Context) {
+	id := c.Param("id")
+
+	var keyword models.Keyword
+	if err := initializers.DB.First(&keyword, id).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "keyword not found")
+		return
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&keyword).Association("Movies").Clear(); err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Delete(&keyword).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionDelete, models.AuditEntityKeyword, keyword.ID, keyword, nil)
+	})
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot delete keyword")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "keyword delete successfully",
+	})
+}
@@ -1,11 +1,14 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
 	"github.com/diana-gemini/ozinshe/internal/models"
 	"github.com/diana-gemini/ozinshe/internal/validations"
 
@@ -18,12 +21,27 @@ type NewMovie struct {
 	CategoriesID  []string `form:"categoriesID" binding:"required" example:"1"`                              // field 2
 	TypeID        string   `form:"typeID" binding:"required" example:"1"`                                    // field 3
 	AgeCategoryID string   `form:"ageCategoryID" binding:"required" example:"13-17"`                         // field 4
-	Year          string   `form:"year" binding:"required" example:"2001"`                                   // field 5
-	Timing        string   `form:"timing" binding:"required" example:"89"`                                   // field 6
-	Keywords      string   `form:"keywords" binding:"required" example:"Film, Horor, Anime"`                 // field 7
+	Year          int      `form:"year" binding:"required,min=1888" example:"2001"`                          // field 5
+	Runtime       int      `form:"runtime" binding:"omitempty,min=1,max=1440" example:"89"`                  // field 6, minutes
+	Timing        string   `form:"timing" example:"1h 29m"`                                                  // deprecated alias of runtime
+	Keywords      string   `form:"keywords" example:"film, horror, anime"`                                   // field 7
 	Description   string   `form:"description" binding:"required" example:"Konec XX veka. Neskolko let ..."` // field 8
-	Director      string   `form:"director" binding:"required" example:"Tomokadzu Tokoro, Hideki Tonokacu"`  // field 9
-	Producer      string   `form:"producer" binding:"required" example:"Satosi Fudzii, Yosiyuki Fudetani"`   // field 10
+}
+
+var errReplaceAssociation = errors.New("cannot replace association")
+
+// latestMovieYear allows adding movies announced a few years ahead.
+func latestMovieYear() int {
+	return time.Now().Year() + 5
+}
+
+// runtime returns the runtime in minutes, falling back to the free-form
+// timing that older clients still send.
+func (m NewMovie) runtime() int {
+	if m.Runtime != 0 {
+		return m.Runtime
+	}
+	return helpers.ParseRuntime(m.Timing)
 }
 
 // CreateMovie godoc
@@ -55,6 +73,17 @@ func CreateMovie(c *gin.Context) {
 		return
 	}
 
+	if newMovie.Year > latestMovieYear() {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid year")
+		return
+	}
+
+	runtime := newMovie.runtime()
+	if runtime < 1 || runtime > 1440 {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid runtime")
+		return
+	}
+
 	categoriesArray := strings.Split(newMovie.CategoriesID[0], ",")
 
 	var categories []models.Category
@@ -146,19 +175,11 @@ func CreateMovie(c *gin.Context) {
 		return
 	}
 
-	videosArray := strings.Split(newSeason.Videos[0], ",")
-
-	var videos []models.Video
-
-	for _, video := range videosArray {
-		tempVideo := models.Video{
-			Link: video,
-		}
-		videos = append(videos, tempVideo)
-	}
+	videos := seasonVideos(newSeason.Videos[0])
 
 	season := models.Season{
 		Videos: videos,
+		Number: 1,
 	}
 	var seasons []models.Season
 	seasons = append(seasons, season)
@@ -170,18 +191,27 @@ func CreateMovie(c *gin.Context) {
 		AgeCategoryID: uint(ageCategoryIDInt),
 		Screenshots:   screenshots,
 		Year:          newMovie.Year,
-		Timing:        newMovie.Timing,
-		Keywords:      newMovie.Keywords,
+		Runtime:       runtime,
 		Description:   newMovie.Description,
-		Director:      newMovie.Director,
-		Producer:      newMovie.Producer,
 		Cover:         coverURL[0],
 		Seasons:       seasons,
 	}
 
-	result := initializers.DB.Create(&movie)
+	err = initializers.DB.Transaction(func(tx *gorm.DB) error {
+		keywords, err := helpers.FindOrCreateKeywords(tx, helpers.ParseKeywords(newMovie.Keywords))
+		if err != nil {
+			return err
+		}
+		movie.Keywords = keywords
+
+		if err := tx.Create(&movie).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionCreate, models.AuditEntityMovie, movie.ID, nil, movie)
+	})
 
-	if result.Error != nil {
+	if err != nil {
 		NewErrorResponse(c, http.StatusInternalServerError, "cannot create movie")
 		return
 	}
@@ -209,9 +239,10 @@ func EditMovie(c *gin.Context) {
 
 	var movie models.Movie
 	result := initializers.DB.Preload("Categories").
+		Preload("Keywords").
 		Preload("Screenshots").
 		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
+			return db.Order("number").Preload("Videos", orderEpisodes)
 		}).
 		First(&movie, id)
 
@@ -257,6 +288,17 @@ func UpdateMovie(c *gin.Context) {
 		return
 	}
 
+	if newMovie.Year > latestMovieYear() {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid year")
+		return
+	}
+
+	runtime := newMovie.runtime()
+	if runtime < 1 || runtime > 1440 {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid runtime")
+		return
+	}
+
 	categoriesArray := strings.Split(newMovie.CategoriesID[0], ",")
 
 	var categories []models.Category
@@ -348,16 +390,7 @@ func UpdateMovie(c *gin.Context) {
 		return
 	}
 
-	videosArray := strings.Split(newSeason.Videos[0], ",")
-
-	var videos []models.Video
-
-	for _, video := range videosArray {
-		tempVideo := models.Video{
-			Link: video,
-		}
-		videos = append(videos, tempVideo)
-	}
+	videos := seasonVideos(newSeason.Videos[0])
 
 	season := models.Season{
 		Videos: videos,
@@ -366,7 +399,7 @@ func UpdateMovie(c *gin.Context) {
 	seasons = append(seasons, season)
 
 	var movie models.Movie
-	result := initializers.DB.First(&movie, id)
+	result := initializers.DB.Preload("Categories").Preload("Keywords").First(&movie, id)
 
 	if err := result.Error; err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "movie not found")
@@ -380,21 +413,53 @@ func UpdateMovie(c *gin.Context) {
 		AgeCategoryID: uint(ageCategoryIDInt),
 		Screenshots:   screenshots,
 		Year:          newMovie.Year,
-		Timing:        newMovie.Timing,
-		Keywords:      newMovie.Keywords,
+		Runtime:       runtime,
 		Description:   newMovie.Description,
-		Director:      newMovie.Director,
-		Producer:      newMovie.Producer,
 		Cover:         coverURL[0],
 		Seasons:       seasons,
 	}
 
-	if err := initializers.DB.Model(&movie).Association("Categories").Replace(updateMovie.Categories); err != nil {
+	before := movie
+
+	err = initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&movie).Association("Categories").Replace(updateMovie.Categories); err != nil {
+			return errReplaceAssociation
+		}
+
+		keywords, err := helpers.FindOrCreateKeywords(tx, helpers.ParseKeywords(newMovie.Keywords))
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&movie).Association("Keywords").Replace(keywords); err != nil {
+			return errReplaceAssociation
+		}
+
+		// the season sent with the movie is added after the existing ones
+		number, err := nextSeasonNumber(tx, movie.ID)
+		if err != nil {
+			return err
+		}
+		updateMovie.Seasons[0].Number = number
+
+		if err := tx.Model(&movie).Updates(&updateMovie).Error; err != nil {
+			return err
+		}
+		updateMovie.Keywords = keywords
+
+		var after models.Movie
+		if err := tx.Preload("Categories").Preload("Keywords").First(&after, movie.ID).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionUpdate, models.AuditEntityMovie, movie.ID, before, after)
+	})
+
+	if errors.Is(err, errReplaceAssociation) {
 		NewErrorResponse(c, http.StatusInternalServerError, "cannot replace association")
 		return
 	}
-
-	if err := initializers.DB.Model(&movie).Updates(&updateMovie).Error; err != nil {
+	if err != nil {
 		NewErrorResponse(c, http.StatusInternalServerError, "cannot update movie")
 		return
 	}
@@ -421,27 +486,35 @@ func DeleteMovie(c *gin.Context) {
 	id := c.Param("id")
 	var movie models.Movie
 
-	if err := initializers.DB.First(&movie, id).Error; err != nil {
+	if err := initializers.DB.Preload("Categories").Preload("Keywords").First(&movie, id).Error; err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "movie not found")
 		return
 	}
 
-	err := initializers.DB.Delete(&movie).Error
-	if err != nil {
-		NewErrorResponse(c, http.StatusNotFound, "failed delete movie")
-		return
-	}
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&movie).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("movie_id = ?", movie.ID).Delete(&models.Favorite{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("movie_id = ?", movie.ID).Delete(&models.Credit{}).Error; err != nil {
+			return err
+		}
 
-	var favorite []models.Favorite
+		if err := tx.Unscoped().Where("movie_id = ?", movie.ID).Delete(&models.MovieTranslation{}).Error; err != nil {
+			return err
+		}
 
-	resultFavorite := initializers.DB.Where("movie_id = ?", id).Find(&favorite)
-	if err := resultFavorite.Error; err != nil {
-		NewErrorResponse(c, http.StatusNotFound, "favorite movie not found")
+		return helpers.WriteAudit(tx, c, models.AuditActionDelete, models.AuditEntityMovie, movie.ID, movie, nil)
+	})
+	if err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "failed delete movie")
 		return
 	}
 
-	initializers.DB.Unscoped().Delete(&favorite)
-
 	c.JSON(http.StatusOK, gin.H{
 		"message": "movie delete successfully",
 	})
@@ -0,0 +1,188 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type NewPerson struct {
+	Name string `form:"name" binding:"required,max=200" example:"Hideki Tonokatsu"`
+	Bio  string `form:"bio" binding:"max=5000" example:"Japanese screenwriter"`
+}
+
+// CreatePerson godoc
+// @Summary CreatePerson
+// @Security ApiKeyAuth
+// @Tags admin-person-controller
+// @ID create-person
+// @Accept multipart/form-data
+// @Produce json
+// @Param newPerson formData NewPerson true "newPerson"
+// @Param photo formData file false "photo"
+// @Success 200 {object} PersonResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/person/create [post]
+func CreatePerson(c *gin.Context) {
+	var newPerson NewPerson
+
+	if err := c.ShouldBind(&newPerson); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	photoURL, ok := uploadOptionalImage(c, "photo")
+	if !ok {
+		return
+	}
+
+	person := models.Person{
+		Name:  newPerson.Name,
+		Photo: photoURL,
+		Bio:   newPerson.Bio,
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&person).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionCreate, models.AuditEntityPerson, person.ID, nil, person)
+	})
+
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot create person")
+		return
+	}
+
+	c.JSON(http.StatusOK, newPersonResponse(&person))
+}
+
+// EditPerson godoc
+// @Summary EditPerson
+// @Security ApiKeyAuth
+// @Tags admin-person-controller
+// @ID edit-person
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Success 200 {object} PersonResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/person/{id}/edit [get]
+func EditPerson(c *gin.Context) {
+	var person models.Person
+	if err := initializers.DB.First(&person, c.Param("id")).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "person not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, newPersonResponse(&person))
+}
+
+// UpdatePerson godoc
+// @Summary UpdatePerson
+// @Security ApiKeyAuth
+// @Tags admin-person-controller
+// @ID update-person
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path integer true "id"
+// @Param newPerson formData NewPerson true "newPerson"
+// @Param photo formData file false "new photo; the old one is kept without it"
+// @Success 200 {object} PersonResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/person/{id}/update [put]
+func UpdatePerson(c *gin.Context) {
+	var newPerson NewPerson
+
+	if err := c.ShouldBind(&newPerson); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var person models.Person
+	if err := initializers.DB.First(&person, c.Param("id")).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "person not found")
+		return
+	}
+
+	photoURL, ok := uploadOptionalImage(c, "photo")
+	if !ok {
+		return
+	}
+
+	before := person
+
+	person.Name = newPerson.Name
+	person.Bio = newPerson.Bio
+	if photoURL != "" {
+		person.Photo = photoURL
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&person).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionUpdate, models.AuditEntityPerson, person.ID, before, person)
+	})
+
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot update person")
+		return
+	}
+
+	c.JSON(http.StatusOK, newPersonResponse(&person))
+}
+
+// DeletePerson godoc
+// @Summary DeletePerson
+// @Security ApiKeyAuth
+// @Tags admin-person-controller
+// @ID delete-person
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/person/{id}/delete [delete]
+func DeletePerson(c *gin.Context) {
+	var person models.Person
+	if err := initializers.DB.First(&person, c.Param("id")).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "person not found")
+		return
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("person_id = ?", person.ID).Delete(&models.Credit{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&person).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionDelete, models.AuditEntityPerson, person.ID, person, nil)
+	})
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot delete person")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "person delete successfully",
+	})
+}
@@ -1,254 +1,517 @@
-package controllers
-
-import (
-	"net/http"
-	"strconv"
-	"strings"
-
-	"github.com/diana-gemini/ozinshe/db/initializers"
-	"github.com/diana-gemini/ozinshe/internal/models"
-	"github.com/diana-gemini/ozinshe/internal/validations"
-
-	"github.com/gin-gonic/gin"
-)
-
-type NewSeason struct {
-	Videos []string `form:"videos" binding:"required" example:"video link"`
-}
-
-// CreateSeason godoc
-// @Summary CreateSeason
-// @Security ApiKeyAuth
-// @Tags admin-movie-season-controller
-// @ID create-season
-// @Accept  multipart/form-data
-// @Produce  json
-// @Param id path integer true "movieID"
-// @Param newSeason formData NewSeason true "newSeason"
-// @Success 200 {integer} integer 1
-// @Failure 400,404 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
-// @Failure default {object} ErrorResponse
-// @Router /admin/movie/{id}/season/create [post]
-func CreateSeason(c *gin.Context) {
-	movieID, err := strconv.Atoi(c.Param("id"))
-	if err != nil {
-		NewErrorResponse(c, http.StatusBadRequest, "cannot convert to int")
-		return
-	}
-
-	if !validations.IsExistValue("movies", "id", movieID) {
-		NewErrorResponse(c, http.StatusBadRequest, "cannot find movie")
-		return
-	}
-
-	var newSeason NewSeason
-	if err := c.ShouldBind(&newSeason); err != nil {
-		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
-		return
-	}
-
-	videosArray := strings.Split(newSeason.Videos[0], ",")
-
-	var videos []models.Video
-
-	for _, video := range videosArray {
-		tempVideo := models.Video{
-			Link: video,
-		}
-		videos = append(videos, tempVideo)
-	}
-
-	season := models.Season{
-		Videos:  videos,
-		MovieID: uint(movieID),
-	}
-
-	result := initializers.DB.Create(&season)
-
-	if result.Error != nil {
-		NewErrorResponse(c, http.StatusInternalServerError, "cannot create season")
-		return
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"season": season,
-	})
-}
-
-// EditSeason godoc
-// @Summary EditSeason
-// @Security ApiKeyAuth
-// @Tags admin-movie-season-controller
-// @ID edit-season
-// @Accept  json
-// @Produce  json
-// @Param id path integer true "id"
-// @Param seasonid path integer true "seasonid"
-// @Success 200 {integer} integer 1
-// @Failure 400,404 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
-// @Failure default {object} ErrorResponse
-// @Router /admin/movie/{id}/season/{seasonid}/edit [get]
-func EditSeason(c *gin.Context) {
-	movieID := c.Param("id")
-	seasonID := c.Param("seasonid")
-
-	var season models.Season
-	result := initializers.DB.Preload("Videos").
-		Where("movie_id = ? and id = ?", movieID, seasonID).First(&season)
-
-	if err := result.Error; err != nil {
-		NewErrorResponse(c, http.StatusNotFound, "season not found")
-		return
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"season": season,
-	})
-}
-
-// UpdateSeason godoc
-// @Summary UpdateSeason
-// @Security ApiKeyAuth
-// @Tags admin-movie-season-controller
-// @ID update-season
-// @Accept  multipart/form-data
-// @Produce  json
-// @Param id path integer true "id"
-// @Param seasonid path integer true "seasonid"
-// @Param newSeason formData NewSeason true "newSeason"
-// @Success 200 {integer} integer 1
-// @Failure 400,404 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
-// @Failure default {object} ErrorResponse
-// @Router /admin/movie/{id}/season/{seasonid}/update [put]
-func UpdateSeason(c *gin.Context) {
-	movieID, err := strconv.Atoi(c.Param("id"))
-	if err != nil {
-		NewErrorResponse(c, http.StatusBadRequest, "cannot convert movieID to int")
-		return
-	}
-
-	seasonID, err := strconv.Atoi(c.Param("seasonid"))
-	if err != nil {
-		NewErrorResponse(c, http.StatusBadRequest, "cannot convert seasonID to int")
-		return
-	}
-
-	if !validations.IsUniqueTwoValue("seasons", "id", "movie_id", uint(seasonID), uint(movieID)) {
-		NewErrorResponse(c, http.StatusBadRequest, "the season of movie does not exist")
-		return
-	}
-
-	var season models.Season
-	result := initializers.DB.Preload("Videos").
-		Where("movie_id = ? and id = ?", movieID, seasonID).First(&season)
-	if err := result.Error; err != nil {
-		NewErrorResponse(c, http.StatusNotFound, "cannot find the season of movie")
-		return
-	}
-
-	var newSeason NewSeason
-	if err := c.ShouldBind(&newSeason); err != nil {
-		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
-		return
-	}
-
-	videosArray := strings.Split(newSeason.Videos[0], ",")
-
-	var videos []models.Video
-
-	for _, video := range videosArray {
-		tempVideo := models.Video{
-			Link: video,
-		}
-		videos = append(videos, tempVideo)
-	}
-
-	updateSeason := models.Season{
-		Videos:  videos,
-		MovieID: uint(movieID),
-	}
-
-	if err := initializers.DB.Model(&season).Association("Videos").Replace(updateSeason.Videos); err != nil {
-		NewErrorResponse(c, http.StatusInternalServerError, "cannot update seasons video")
-		return
-	}
-
-	if err := initializers.DB.Model(&season).Updates(&updateSeason).Error; err != nil {
-		NewErrorResponse(c, http.StatusInternalServerError, "cannot update season")
-		return
-	}
-
-	if err := initializers.DB.Unscoped().Where("season_id IS NULL OR season_id = ?", 0).
-		Delete(&models.Video{}).Error; err != nil {
-		NewErrorResponse(c, http.StatusInternalServerError, "cannot delete video without association")
-		return
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"season": updateSeason,
-	})
-}
-
-// DeleteSeason godoc
-// @Summary DeleteSeason
-// @Security ApiKeyAuth
-// @Tags admin-movie-season-controller
-// @ID delete-season
-// @Accept  json
-// @Produce  json
-// @Param id path integer true "id"
-// @Param seasonid path integer true "seasonid"
-// @Success 200 {integer} integer 1
-// @Failure 400,404 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
-// @Failure default {object} ErrorResponse
-// @Router /admin/movie/{id}/season/{seasonid}/delete [delete]
-func DeleteSeason(c *gin.Context) {
-	movieID := c.Param("id")
-	seasonID := c.Param("seasonid")
-
-	if !validations.IsExistValue("movies", "id", movieID) {
-		NewErrorResponse(c, http.StatusNotFound, "movie does not exist")
-		return
-	}
-
-	var season models.Season
-	if err := initializers.DB.Where("movie_id = ?", movieID).First(&season, seasonID).Error; err != nil {
-		NewErrorResponse(c, http.StatusNotFound, "cannot find season")
-		return
-	}
-
-	if err := initializers.DB.Model(&season).Association("Videos").Clear(); err != nil {
-		NewErrorResponse(c, http.StatusInternalServerError, "cannot clear season associations")
-		return
-	}
-
-	if err := initializers.DB.Unscoped().Delete(&season).Error; err != nil {
-		NewErrorResponse(c, http.StatusNotFound, "cannot delete season")
-		return
-	}
-
-	if err := initializers.DB.Unscoped().Where("season_id IS NULL OR season_id = ?", 0).
-		Delete(&models.Video{}).Error; err != nil {
-		NewErrorResponse(c, http.StatusInternalServerError, "cannot delete video without association")
-		return
-	}
-
-	// var videos []models.Video
-	// if err := initializers.DB.Where("season_id = ?", seasonID).Find(&videos).Error; err != nil {
-	// 	NewErrorResponse(c, http.StatusNotFound, "cannot find videos")
-	// 	return
-	// }
-
-	// if err := initializers.DB.Unscoped().Delete(&videos).Error; err != nil {
-	// 	NewErrorResponse(c, http.StatusNotFound, "cannot delete videos")
-	// 	return
-	// }
-
-	c.JSON(http.StatusOK, gin.H{
-		"message": "season and video delete successfully",
-	})
-}
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+	"github.com/diana-gemini/ozinshe/internal/validations"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type NewSeason struct {
+	Videos []string `form:"videos" binding:"required" example:"video link"`
+}
+
+type SeasonDetails struct {
+	// Number is the next free one when left out
+	Number      int    `form:"number" binding:"min=0" example:"1"`
+	Title       string `form:"title" binding:"max=200" example:"Season 1"`
+	Year        int    `form:"year" binding:"omitempty,min=1888" example:"2001"`
+	Description string `form:"description" binding:"max=5000" example:"The first season"`
+}
+
+type SeasonOrder struct {
+	ID uint `json:"id" binding:"required" example:"1"`
+	// Episodes lists the IDs of the episodes in their new order; the order
+	// of the episodes is kept without it
+	Episodes []uint `json:"episodes" example:"1,2,3"`
+}
+
+type NewSeasonOrder struct {
+	Seasons []SeasonOrder `json:"seasons" binding:"required,min=1,dive"`
+}
+
+// seasonVideos turns the comma separated links into the episodes of a season,
+// numbered in their order.
+func seasonVideos(links string) []models.Video {
+	var videos []models.Video
+
+	for i, link := range strings.Split(links, ",") {
+		videos = append(videos, models.Video{
+			Link:          link,
+			EpisodeNumber: i + 1,
+			Position:      i + 1,
+		})
+	}
+
+	return videos
+}
+
+// updateSeasonVideos replaces the episodes of a season with the given ones.
+// Episodes are matched by their number: a current episode keeps its id and
+// details and only gets the new link, the others are created or deleted.
+func updateSeasonVideos(tx *gorm.DB, seasonID uint, current, videos []models.Video) error {
+	currentByNumber := map[int]models.Video{}
+	for _, video := range current {
+		currentByNumber[video.EpisodeNumber] = video
+	}
+
+	for _, video := range videos {
+		old, ok := currentByNumber[video.EpisodeNumber]
+		if !ok {
+			video.SeasonID = seasonID
+			if err := tx.Create(&video).Error; err != nil {
+				return err
+			}
+			continue
+		}
+		delete(currentByNumber, video.EpisodeNumber)
+
+		err := tx.Model(&old).Updates(map[string]interface{}{
+			"link":     video.Link,
+			"position": video.Position,
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, old := range currentByNumber {
+		if err := tx.Unscoped().Delete(&old).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nextSeasonNumber returns the number after the last season of the movie.
+func nextSeasonNumber(tx *gorm.DB, movieID uint) (int, error) {
+	var number int
+	err := tx.Unscoped().Model(&models.Season{}).Where("movie_id = ?", movieID).
+		Select("COALESCE(MAX(number), 0)").Scan(&number).Error
+
+	return number + 1, err
+}
+
+// findSeason loads the season of the movie in the request by its number,
+// writing the error response when there is none.
+func findSeason(c *gin.Context) (models.Season, bool) {
+	var season models.Season
+	result := initializers.DB.Preload("Videos", orderEpisodes).
+		Where("movie_id = ? AND number = ?", c.Param("id"), c.Param("seasonnumber")).First(&season)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "season not found")
+		return season, false
+	}
+
+	return season, true
+}
+
+// bindSeasonDetails validates the details of a season in the request. It
+// writes the error response itself and reports false on failure.
+func bindSeasonDetails(c *gin.Context, movieID, seasonID uint) (SeasonDetails, bool) {
+	var details SeasonDetails
+	if err := c.ShouldBind(&details); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return details, false
+	}
+
+	if details.Year > latestMovieYear() {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid year")
+		return details, false
+	}
+
+	if details.Number != 0 {
+		var count int64
+		err := initializers.DB.Model(&models.Season{}).
+			Where("movie_id = ? AND number = ? AND id <> ?", movieID, details.Number, seasonID).
+			Count(&count).Error
+		if err != nil {
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+			return details, false
+		}
+		if count > 0 {
+			NewErrorResponse(c, http.StatusConflict, "season number already exists")
+			return details, false
+		}
+	}
+
+	return details, true
+}
+
+// CreateSeason godoc
+// @Summary CreateSeason
+// @Security ApiKeyAuth
+// @Tags admin-movie-season-controller
+// @ID create-season
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param id path integer true "movieID"
+// @Param newSeason formData NewSeason true "newSeason"
+// @Param seasonDetails formData SeasonDetails false "seasonDetails"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/season/create [post]
+func CreateSeason(c *gin.Context) {
+	movieID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "cannot convert to int")
+		return
+	}
+
+	if !validations.IsExistValue("movies", "id", movieID) {
+		NewErrorResponse(c, http.StatusBadRequest, "cannot find movie")
+		return
+	}
+
+	var newSeason NewSeason
+	if err := c.ShouldBind(&newSeason); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	details, ok := bindSeasonDetails(c, uint(movieID), 0)
+	if !ok {
+		return
+	}
+
+	videos := seasonVideos(newSeason.Videos[0])
+
+	season := models.Season{
+		Videos:      videos,
+		MovieID:     uint(movieID),
+		Number:      details.Number,
+		Title:       details.Title,
+		Year:        details.Year,
+		Description: details.Description,
+	}
+
+	err = initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if season.Number == 0 {
+			number, err := nextSeasonNumber(tx, season.MovieID)
+			if err != nil {
+				return err
+			}
+			season.Number = number
+		}
+
+		if err := tx.Create(&season).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionCreate, models.AuditEntitySeason, season.ID, nil, season)
+	})
+
+	if validations.IsDuplicateKey(err) {
+		NewErrorResponse(c, http.StatusConflict, "season number already exists")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot create season")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"season": season,
+	})
+}
+
+// EditSeason godoc
+// @Summary EditSeason
+// @Security ApiKeyAuth
+// @Tags admin-movie-season-controller
+// @ID edit-season
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Param seasonnumber path integer true "season number"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/season/{seasonnumber}/edit [get]
+func EditSeason(c *gin.Context) {
+	season, ok := findSeason(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"season": season,
+	})
+}
+
+// UpdateSeason godoc
+// @Summary UpdateSeason
+// @Security ApiKeyAuth
+// @Tags admin-movie-season-controller
+// @ID update-season
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param id path integer true "id"
+// @Param seasonnumber path integer true "season number"
+// @Param newSeason formData NewSeason true "newSeason"
+// @Param seasonDetails formData SeasonDetails false "seasonDetails; the number is kept when left out"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/season/{seasonnumber}/update [put]
+func UpdateSeason(c *gin.Context) {
+	season, ok := findSeason(c)
+	if !ok {
+		return
+	}
+
+	var newSeason NewSeason
+	if err := c.ShouldBind(&newSeason); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	details, ok := bindSeasonDetails(c, season.MovieID, season.ID)
+	if !ok {
+		return
+	}
+
+	videos := seasonVideos(newSeason.Videos[0])
+
+	updateSeason := models.Season{
+		MovieID:     season.MovieID,
+		Number:      details.Number,
+		Title:       details.Title,
+		Year:        details.Year,
+		Description: details.Description,
+	}
+	if updateSeason.Number == 0 {
+		updateSeason.Number = season.Number
+	}
+
+	before := season
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := updateSeasonVideos(tx, season.ID, season.Videos, videos); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&season).Select("Number", "Title", "Year", "Description").
+			Updates(&updateSeason).Error; err != nil {
+			return err
+		}
+
+		var after models.Season
+		if err := tx.Preload("Videos", orderEpisodes).First(&after, season.ID).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionUpdate, models.AuditEntitySeason, season.ID, before, after)
+	})
+
+	if validations.IsDuplicateKey(err) {
+		NewErrorResponse(c, http.StatusConflict, "season number already exists")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot update season")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"season": updateSeason,
+	})
+}
+
+// DeleteSeason godoc
+// @Summary DeleteSeason
+// @Security ApiKeyAuth
+// @Tags admin-movie-season-controller
+// @ID delete-season
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Param seasonnumber path integer true "season number"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/season/{seasonnumber}/delete [delete]
+func DeleteSeason(c *gin.Context) {
+	if !validations.IsExistValue("movies", "id", c.Param("id")) {
+		NewErrorResponse(c, http.StatusNotFound, "movie does not exist")
+		return
+	}
+
+	season, ok := findSeason(c)
+	if !ok {
+		return
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&season).Association("Videos").Clear(); err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Delete(&season).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("season_id IS NULL OR season_id = ?", 0).
+			Delete(&models.Video{}).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionDelete, models.AuditEntitySeason, season.ID, season, nil)
+	})
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot delete season")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "season and video delete successfully",
+	})
+}
+
+// ReorderSeasons godoc
+// @Summary ReorderSeasons
+// @Security ApiKeyAuth
+// @Tags admin-movie-season-controller
+// @ID reorder-seasons
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Param newSeasonOrder body NewSeasonOrder true "every season of the movie in its new order"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/season/reorder [put]
+func ReorderSeasons(c *gin.Context) {
+	var userInput NewSeasonOrder
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var movie models.Movie
+	if err := initializers.DB.First(&movie, c.Param("id")).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "movie not found")
+		return
+	}
+
+	var seasons []models.Season
+	if err := initializers.DB.Preload("Videos", orderEpisodes).Where("movie_id = ?", movie.ID).
+		Find(&seasons).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot find seasons")
+		return
+	}
+
+	seasonsByID := map[uint]models.Season{}
+	for _, season := range seasons {
+		seasonsByID[season.ID] = season
+	}
+
+	if len(userInput.Seasons) != len(seasons) {
+		NewErrorResponse(c, http.StatusBadRequest, "every season of the movie must be listed once")
+		return
+	}
+
+	listed := map[uint]bool{}
+	for _, order := range userInput.Seasons {
+		season, ok := seasonsByID[order.ID]
+		if !ok || listed[order.ID] {
+			NewErrorResponse(c, http.StatusBadRequest, "every season of the movie must be listed once")
+			return
+		}
+		listed[order.ID] = true
+
+		if order.Episodes != nil && !listsEveryEpisode(order.Episodes, season.Videos) {
+			NewErrorResponse(c, http.StatusBadRequest, "every episode of the season must be listed once")
+			return
+		}
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		// the numbers are freed first, as the seasons may swap them
+		if err := tx.Model(&models.Season{}).Where("movie_id = ?", movie.ID).
+			Update("number", gorm.Expr("-number")).Error; err != nil {
+			return err
+		}
+
+		for i, order := range userInput.Seasons {
+			if err := tx.Model(&models.Season{}).Where("id = ?", order.ID).
+				Update("number", i+1).Error; err != nil {
+				return err
+			}
+
+			for j, episodeID := range order.Episodes {
+				if err := tx.Model(&models.Video{}).Where("id = ?", episodeID).
+					Update("position", j+1).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, order := range userInput.Seasons {
+			var after models.Season
+			if err := tx.Preload("Videos", orderEpisodes).First(&after, order.ID).Error; err != nil {
+				return err
+			}
+
+			err := helpers.WriteAudit(tx, c, models.AuditActionUpdate, models.AuditEntitySeason, after.ID, seasonsByID[order.ID], after)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot reorder seasons")
+		return
+	}
+
+	seasons = nil
+	if err := initializers.DB.Preload("Videos", orderEpisodes).Where("movie_id = ?", movie.ID).
+		Order("number").Find(&seasons).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot find seasons")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"seasons": seasons,
+	})
+}
+
+// listsEveryEpisode reports whether the IDs name every episode exactly once.
+func listsEveryEpisode(ids []uint, videos []models.Video) bool {
+	if len(ids) != len(videos) {
+		return false
+	}
+
+	remaining := map[uint]bool{}
+	for _, video := range videos {
+		remaining[video.ID] = true
+	}
+
+	for _, id := range ids {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+
+	return true
+}
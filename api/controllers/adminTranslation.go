@@ -0,0 +1,465 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type MovieTranslationInput struct {
+	NameOfProject string `json:"nameOfProject" binding:"max=200" example:"Хеллсинг"`
+	Description   string `json:"description" binding:"max=5000" example:"Конец XX века. Несколько лет ..."`
+}
+
+type NameTranslationInput struct {
+	Name string `json:"name" binding:"required,max=200" example:"Ужасы"`
+}
+
+type MovieTranslationResponse struct {
+	Locale        string
+	NameOfProject string
+	Description   string
+}
+
+type NameTranslationResponse struct {
+	Locale string
+	Name   string
+}
+
+// translationLocale returns the locale of the request path, writing the
+// error response when it is not supported.
+func translationLocale(c *gin.Context) (string, bool) {
+	locale := c.Param("locale")
+	for _, supported := range models.Locales {
+		if locale == supported {
+			return locale, true
+		}
+	}
+
+	NewErrorResponse(c, http.StatusBadRequest, "unsupported locale")
+	return "", false
+}
+
+// translationTable describes one translation table for the shared handlers.
+// T is the translation model, I the request body and R the response.
+type translationTable[T, I, R any] struct {
+	owner       interface{} // model of the translated entries
+	ownerColumn string      // column of the entry in the translation table
+	notFound    string
+	entityType  string
+	// fill copies the input into the translation of the entry; it reports
+	// false when the input holds no text
+	fill     func(translation *T, ownerID uint, locale string, input I) bool
+	response func(translation *T) R
+	model    func(translation *T) *gorm.Model
+}
+
+var movieTranslations = translationTable[models.MovieTranslation, MovieTranslationInput, MovieTranslationResponse]{
+	owner:       &models.Movie{},
+	ownerColumn: "movie_id",
+	notFound:    "movie not found",
+	entityType:  models.AuditEntityMovieTranslation,
+	fill: func(translation *models.MovieTranslation, ownerID uint, locale string, input MovieTranslationInput) bool {
+		translation.MovieID = ownerID
+		translation.Locale = locale
+		translation.NameOfProject = input.NameOfProject
+		translation.Description = input.Description
+		return input.NameOfProject != "" || input.Description != ""
+	},
+	response: func(translation *models.MovieTranslation) MovieTranslationResponse {
+		return MovieTranslationResponse{
+			Locale:        translation.Locale,
+			NameOfProject: translation.NameOfProject,
+			Description:   translation.Description,
+		}
+	},
+	model: func(translation *models.MovieTranslation) *gorm.Model { return &translation.Model },
+}
+
+var categoryTranslations = translationTable[models.CategoryTranslation, NameTranslationInput, NameTranslationResponse]{
+	owner:       &models.Category{},
+	ownerColumn: "category_id",
+	notFound:    "category not found",
+	entityType:  models.AuditEntityCategoryTranslation,
+	fill: func(translation *models.CategoryTranslation, ownerID uint, locale string, input NameTranslationInput) bool {
+		translation.CategoryID = ownerID
+		translation.Locale = locale
+		translation.CategoryName = input.Name
+		return true
+	},
+	response: func(translation *models.CategoryTranslation) NameTranslationResponse {
+		return NameTranslationResponse{Locale: translation.Locale, Name: translation.CategoryName}
+	},
+	model: func(translation *models.CategoryTranslation) *gorm.Model { return &translation.Model },
+}
+
+var typeTranslations = translationTable[models.TypeTranslation, NameTranslationInput, NameTranslationResponse]{
+	owner:       &models.Type{},
+	ownerColumn: "type_id",
+	notFound:    "type not found",
+	entityType:  models.AuditEntityTypeTranslation,
+	fill: func(translation *models.TypeTranslation, ownerID uint, locale string, input NameTranslationInput) bool {
+		translation.TypeID = ownerID
+		translation.Locale = locale
+		translation.TypeName = input.Name
+		return true
+	},
+	response: func(translation *models.TypeTranslation) NameTranslationResponse {
+		return NameTranslationResponse{Locale: translation.Locale, Name: translation.TypeName}
+	},
+	model: func(translation *models.TypeTranslation) *gorm.Model { return &translation.Model },
+}
+
+var ageCategoryTranslations = translationTable[models.AgeCategoryTranslation, NameTranslationInput, NameTranslationResponse]{
+	owner:       &models.AgeCategory{},
+	ownerColumn: "age_category_id",
+	notFound:    "age category not found",
+	entityType:  models.AuditEntityAgeCategoryTranslation,
+	fill: func(translation *models.AgeCategoryTranslation, ownerID uint, locale string, input NameTranslationInput) bool {
+		translation.AgeCategoryID = ownerID
+		translation.Locale = locale
+		translation.AgeCategoryName = input.Name
+		return true
+	},
+	response: func(translation *models.AgeCategoryTranslation) NameTranslationResponse {
+		return NameTranslationResponse{Locale: translation.Locale, Name: translation.AgeCategoryName}
+	},
+	model: func(translation *models.AgeCategoryTranslation) *gorm.Model { return &translation.Model },
+}
+
+// findOwner returns the id of the translated entry of the request path,
+// writing the error response when there is none.
+func (t translationTable[T, I, R]) findOwner(c *gin.Context) (uint, bool) {
+	var ownerID uint
+	err := initializers.DB.Model(t.owner).Select("id").Where("id = ?", c.Param("id")).Scan(&ownerID).Error
+	if err != nil || ownerID == 0 {
+		NewErrorResponse(c, http.StatusNotFound, t.notFound)
+		return 0, false
+	}
+
+	return ownerID, true
+}
+
+func (t translationTable[T, I, R]) list(c *gin.Context) {
+	ownerID, ok := t.findOwner(c)
+	if !ok {
+		return
+	}
+
+	var translations []T
+	if err := initializers.DB.Where(t.ownerColumn+" = ?", ownerID).Order("locale").Find(&translations).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get translations")
+		return
+	}
+
+	response := make([]R, len(translations))
+	for i := range translations {
+		response[i] = t.response(&translations[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// set stores a new or changed translation along with its audit entry.
+func (t translationTable[T, I, R]) set(c *gin.Context) {
+	locale, ok := translationLocale(c)
+	if !ok {
+		return
+	}
+
+	var userInput I
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	ownerID, ok := t.findOwner(c)
+	if !ok {
+		return
+	}
+
+	var translation T
+	if err := initializers.DB.Where(t.ownerColumn+" = ? AND locale = ?", ownerID, locale).
+		Limit(1).Find(&translation).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get translations")
+		return
+	}
+
+	before := translation
+
+	if !t.fill(&translation, ownerID, locale, userInput) {
+		NewErrorResponse(c, http.StatusBadRequest, "translation is empty")
+		return
+	}
+
+	model := t.model(&translation)
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		isNew := model.ID == 0
+
+		if err := tx.Save(&translation).Error; err != nil {
+			return err
+		}
+
+		if isNew {
+			return helpers.WriteAudit(tx, c, models.AuditActionCreate, t.entityType, model.ID, nil, translation)
+		}
+		return helpers.WriteAudit(tx, c, models.AuditActionUpdate, t.entityType, model.ID, before, translation)
+	})
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot save translation")
+		return
+	}
+
+	c.JSON(http.StatusOK, t.response(&translation))
+}
+
+func (t translationTable[T, I, R]) delete(c *gin.Context) {
+	locale, ok := translationLocale(c)
+	if !ok {
+		return
+	}
+
+	var translation T
+	if err := initializers.DB.Where(t.ownerColumn+" = ? AND locale = ?", c.Param("id"), locale).
+		First(&translation).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "translation not found")
+		return
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Delete(&translation).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionDelete, t.entityType, t.model(&translation).ID, translation, nil)
+	})
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot delete translation")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "translation delete successfully",
+	})
+}
+
+// GetMovieTranslations godoc
+// @Summary GetMovieTranslations
+// @Security ApiKeyAuth
+// @Tags admin-translation-controller
+// @ID get-movie-translations
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "movieID"
+// @Success 200 {array} MovieTranslationResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/translations [get]
+func GetMovieTranslations(c *gin.Context) {
+	movieTranslations.list(c)
+}
+
+// SetMovieTranslation godoc
+// @Summary SetMovieTranslation
+// @Security ApiKeyAuth
+// @Tags admin-translation-controller
+// @ID set-movie-translation
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "movieID"
+// @Param locale path string true "kk, ru or en"
+// @Param translation body MovieTranslationInput true "empty fields fall back to the next locale"
+// @Success 200 {object} MovieTranslationResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/translation/{locale} [put]
+func SetMovieTranslation(c *gin.Context) {
+	movieTranslations.set(c)
+}
+
+// DeleteMovieTranslation godoc
+// @Summary DeleteMovieTranslation
+// @Security ApiKeyAuth
+// @Tags admin-translation-controller
+// @ID delete-movie-translation
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "movieID"
+// @Param locale path string true "kk, ru or en"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/movie/{id}/translation/{locale} [delete]
+func DeleteMovieTranslation(c *gin.Context) {
+	movieTranslations.delete(c)
+}
+
+// GetCategoryTranslations godoc
+// @Summary GetCategoryTranslations
+// @Security ApiKeyAuth
+// @Tags admin-translation-controller
+// @ID get-category-translations
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "categoryID"
+// @Success 200 {array} NameTranslationResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/category/{id}/translations [get]
+func GetCategoryTranslations(c *gin.Context) {
+	categoryTranslations.list(c)
+}
+
+// SetCategoryTranslation godoc
+// @Summary SetCategoryTranslation
+// @Security ApiKeyAuth
+// @Tags admin-translation-controller
+// @ID set-category-translation
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "categoryID"
+// @Param locale path string true "kk, ru or en"
+// @Param translation body NameTranslationInput true "translation"
+// @Success 200 {object} NameTranslationResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/category/{id}/translation/{locale} [put]
+func SetCategoryTranslation(c *gin.Context) {
+	categoryTranslations.set(c)
+}
+
+// DeleteCategoryTranslation godoc
+// @Summary DeleteCategoryTranslation
+// @Security ApiKeyAuth
+// @Tags admin-translation-controller
+// @ID delete-category-translation
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "categoryID"
+// @Param locale path string true "kk, ru or en"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/category/{id}/translation/{locale} [delete]
+func DeleteCategoryTranslation(c *gin.Context) {
+	categoryTranslations.delete(c)
+}
+
+// GetTypeTranslations godoc
+// @Summary GetTypeTranslations
+// @Security ApiKeyAuth
+// @Tags admin-translation-controller
+// @ID get-type-translations
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "typeID"
+// @Success 200 {array} NameTranslationResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/type/{id}/translations [get]
+func GetTypeTranslations(c *gin.Context) {
+	typeTranslations.list(c)
+}
+
+// SetTypeTranslation godoc
+// @Summary SetTypeTranslation
+// @Security ApiKeyAuth
+// @Tags admin-translation-controller
+// @ID set-type-translation
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "typeID"
+// @Param locale path string true "kk, ru or en"
+// @Param translation body NameTranslationInput true "translation"
+// @Success 200 {object} NameTranslationResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/type/{id}/translation/{locale} [put]
+func SetTypeTranslation(c *gin.Context) {
+	typeTranslations.set(c)
+}
+
+// DeleteTypeTranslation godoc
+// @Summary DeleteTypeTranslation
+// @Security ApiKeyAuth
+// @Tags admin-translation-controller
+// @ID delete-type-translation
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "typeID"
+// @Param locale path string true "kk, ru or en"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/type/{id}/translation/{locale} [delete]
+func DeleteTypeTranslation(c *gin.Context) {
+	typeTranslations.delete(c)
+}
+
+// GetAgeCategoryTranslations godoc
+// @Summary GetAgeCategoryTranslations
+// @Security ApiKeyAuth
+// @Tags admin-translation-controller
+// @ID get-age-category-translations
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "ageCategoryID"
+// @Success 200 {array} NameTranslationResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/agecategory/{id}/translations [get]
+func GetAgeCategoryTranslations(c *gin.Context) {
+	ageCategoryTranslations.list(c)
+}
+
+// SetAgeCategoryTranslation godoc
+// @Summary SetAgeCategoryTranslation
+// @Security ApiKeyAuth
+// @Tags admin-translation-controller
+// @ID set-age-category-translation
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "ageCategoryID"
+// @Param locale path string true "kk, ru or en"
+// @Param translation body NameTranslationInput true "translation"
+// @Success 200 {object} NameTranslationResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/agecategory/{id}/translation/{locale} [put]
+func SetAgeCategoryTranslation(c *gin.Context) {
+	ageCategoryTranslations.set(c)
+}
+
+// DeleteAgeCategoryTranslation godoc
+// @Summary DeleteAgeCategoryTranslation
+// @Security ApiKeyAuth
+// @Tags admin-translation-controller
+// @ID delete-age-category-translation
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "ageCategoryID"
+// @Param locale path string true "kk, ru or en"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/agecategory/{id}/translation/{locale} [delete]
+func DeleteAgeCategoryTranslation(c *gin.Context) {
+	ageCategoryTranslations.delete(c)
+}
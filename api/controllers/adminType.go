@@ -4,10 +4,12 @@ import (
 	"net/http"
 
 	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
 	"github.com/diana-gemini/ozinshe/internal/models"
 	"github.com/diana-gemini/ozinshe/internal/validations"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 type NewType struct {
@@ -44,9 +46,15 @@ func CreateTypeOfProject(c *gin.Context) {
 		TypeName: userInput.TypeName,
 	}
 
-	result := initializers.DB.Create(&typeOfProject)
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&typeOfProject).Error; err != nil {
+			return err
+		}
 
-	if result.Error != nil {
+		return helpers.WriteAudit(tx, c, models.AuditActionCreate, models.AuditEntityType, typeOfProject.ID, nil, typeOfProject)
+	})
+
+	if err != nil {
 		NewErrorResponse(c, http.StatusInternalServerError, "cannot create category")
 		return
 	}
@@ -126,9 +134,21 @@ func UpdateTypeOfProject(c *gin.Context) {
 		TypeName: userInput.TypeName,
 	}
 
-	result = initializers.DB.Model(&typeOfProject).Updates(&updateTypeOfProject)
+	before := typeOfProject
 
-	if result.Error != nil {
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&typeOfProject).Updates(&updateTypeOfProject).Error; err != nil {
+			return err
+		}
+
+		if err := tx.First(&typeOfProject, typeOfProject.ID).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionUpdate, models.AuditEntityType, typeOfProject.ID, before, typeOfProject)
+	})
+
+	if err != nil {
 		NewErrorResponse(c, http.StatusInternalServerError, "cannot update type")
 		return
 	}
@@ -161,7 +181,17 @@ func DeleteTypeOfProject(c *gin.Context) {
 		return
 	}
 
-	err := initializers.DB.Delete(&typeOfProject).Error
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&typeOfProject).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("type_id = ?", typeOfProject.ID).Delete(&models.TypeTranslation{}).Error; err != nil {
+			return err
+		}
+
+		return helpers.WriteAudit(tx, c, models.AuditActionDelete, models.AuditEntityType, typeOfProject.ID, typeOfProject, nil)
+	})
 	if err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "cannot find type")
 		return
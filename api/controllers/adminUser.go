@@ -0,0 +1,534 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const signupDateLayout = "2006-01-02"
+
+type AdminUserResponse struct {
+	ID                  uint
+	Email               string
+	Username            string
+	MobilePhone         string
+	BirthDate           string
+	Role                string
+	EmailVerified       bool
+	TwoFactorEnabled    bool
+	Suspended           bool
+	SuspendedAt         *time.Time
+	SuspendedUntil      *time.Time
+	SuspensionReason    string
+	DeletionScheduledAt *time.Time
+	CreatedAt           time.Time
+}
+
+type AdminUserList struct {
+	Users []AdminUserResponse
+	Page  int
+	Limit int
+	Total int64
+}
+
+type SuspendUserInput struct {
+	Reason string `json:"reason" example:"spam"`
+	// Until is an RFC 3339 time; without it the user is banned until unbanned.
+	Until *time.Time `json:"until" example:"2030-01-01T00:00:00Z"`
+}
+
+type ChangeUserRoleInput struct {
+	Role string `json:"role" binding:"required" example:"content_editor"`
+}
+
+// GetUsers godoc
+// @Summary GetUsers
+// @Security ApiKeyAuth
+// @Tags admin-user-controller
+// @ID get-users
+// @Accept  json
+// @Produce  json
+// @Param page query integer false "page, starting from 1"
+// @Param limit query integer false "users per page"
+// @Param search query string false "part of the email or username"
+// @Param role query string false "role name"
+// @Param verified query boolean false "email verified"
+// @Param suspended query boolean false "suspended or banned"
+// @Param createdfrom query string false "signed up on or after, YYYY-MM-DD"
+// @Param createdto query string false "signed up on or before, YYYY-MM-DD"
+// @Success 200 {object} AdminUserList
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/users [get]
+func GetUsers(c *gin.Context) {
+	page, limit, ok := parsePagination(c)
+	if !ok {
+		return
+	}
+
+	query := initializers.DB.Model(&models.User{})
+
+	if search := strings.TrimSpace(c.Query("search")); search != "" {
+		pattern := "%" + helpers.EscapeLike(strings.ToLower(search)) + "%"
+		query = query.Where("LOWER(email) LIKE ? ESCAPE '\\' OR LOWER(username) LIKE ? ESCAPE '\\'", pattern, pattern)
+	}
+
+	if roleName := c.Query("role"); roleName != "" {
+		var role models.Role
+		if err := initializers.DB.First(&role, "name = ?", roleName).Error; err != nil {
+			NewErrorResponse(c, http.StatusBadRequest, "role not found")
+			return
+		}
+		query = query.Where("role_id = ?", role.ID)
+	}
+
+	if value := c.Query("verified"); value != "" {
+		verified, err := strconv.ParseBool(value)
+		if err != nil {
+			NewErrorResponse(c, http.StatusBadRequest, "invalid verified filter")
+			return
+		}
+		if verified {
+			query = query.Where("email_verified_at IS NOT NULL")
+		} else {
+			query = query.Where("email_verified_at IS NULL")
+		}
+	}
+
+	if value := c.Query("suspended"); value != "" {
+		suspended, err := strconv.ParseBool(value)
+		if err != nil {
+			NewErrorResponse(c, http.StatusBadRequest, "invalid suspended filter")
+			return
+		}
+		active := "suspended_at IS NOT NULL AND (suspended_until IS NULL OR suspended_until > ?)"
+		if suspended {
+			query = query.Where(active, time.Now())
+		} else {
+			query = query.Not(active, time.Now())
+		}
+	}
+
+	if value := c.Query("createdfrom"); value != "" {
+		from, err := time.Parse(signupDateLayout, value)
+		if err != nil {
+			NewErrorResponse(c, http.StatusBadRequest, "invalid createdfrom date")
+			return
+		}
+		query = query.Where("created_at >= ?", from)
+	}
+
+	if value := c.Query("createdto"); value != "" {
+		to, err := time.Parse(signupDateLayout, value)
+		if err != nil {
+			NewErrorResponse(c, http.StatusBadRequest, "invalid createdto date")
+			return
+		}
+		query = query.Where("created_at < ?", to.AddDate(0, 0, 1))
+	}
+
+	// a new session lets the count and the page query share the filters
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get users")
+		return
+	}
+
+	var users []models.User
+	err := query.Order("id").Offset((page - 1) * limit).Limit(limit).Find(&users).Error
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get users")
+		return
+	}
+
+	roleNames, err := roleNamesByID()
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get roles")
+		return
+	}
+
+	response := AdminUserList{
+		Users: make([]AdminUserResponse, 0, len(users)),
+		Page:  page,
+		Limit: limit,
+		Total: total,
+	}
+	for i := range users {
+		response.Users = append(response.Users, newAdminUserResponse(&users[i], roleNames))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetUser godoc
+// @Summary GetUser
+// @Security ApiKeyAuth
+// @Tags admin-user-controller
+// @ID get-user
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Success 200 {object} AdminUserResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/user/{id} [get]
+func GetUser(c *gin.Context) {
+	id := c.Param("id")
+
+	var user models.User
+	result := initializers.DB.First(&user, id)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	roleNames, err := roleNamesByID()
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get roles")
+		return
+	}
+
+	c.JSON(http.StatusOK, newAdminUserResponse(&user, roleNames))
+}
+
+// GetUserFavorites godoc
+// @Summary GetUserFavorites
+// @Security ApiKeyAuth
+// @Tags admin-user-controller
+// @ID get-user-favorites
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/user/{id}/favorites [get]
+func GetUserFavorites(c *gin.Context) {
+	id := c.Param("id")
+
+	var user models.User
+	result := initializers.DB.First(&user, id)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	var favoriteMovies []models.Favorite
+	result = initializers.DB.Where("user_id = ?", user.ID).Find(&favoriteMovies)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get favorite movies")
+		return
+	}
+
+	response := make([]FavoriteResponse, 0, len(favoriteMovies))
+	for _, favorite := range favoriteMovies {
+		response = append(response, FavoriteResponse{
+			UserID:    favorite.UserID,
+			ProfileID: favorite.ProfileID,
+			MovieID:   favorite.MovieID,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"favoriteMovies": response,
+	})
+}
+
+// SuspendUser godoc
+// @Summary SuspendUser
+// @Security ApiKeyAuth
+// @Tags admin-user-controller
+// @ID suspend-user
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Param input body SuspendUserInput true "reason and end of the suspension; no end bans the user"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/user/{id}/suspend [post]
+func SuspendUser(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+	id := c.Param("id")
+
+	var userInput SuspendUserInput
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	if userInput.Until != nil && !userInput.Until.After(time.Now()) {
+		NewErrorResponse(c, http.StatusBadRequest, "suspension end should be in the future")
+		return
+	}
+
+	var user models.User
+	result := initializers.DB.First(&user, id)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if user.ID == authUser.ID {
+		NewErrorResponse(c, http.StatusBadRequest, "you cannot suspend yourself")
+		return
+	}
+
+	result = initializers.DB.Model(&user).Updates(map[string]interface{}{
+		"suspended_at":      time.Now(),
+		"suspended_until":   userInput.Until,
+		"suspension_reason": userInput.Reason,
+	})
+
+	if result.Error != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot suspend user")
+		return
+	}
+
+	if err := helpers.RevokeUserTokens(user.ID); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to revoke tokens")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "user suspended successfully",
+	})
+}
+
+// UnsuspendUser godoc
+// @Summary UnsuspendUser
+// @Security ApiKeyAuth
+// @Tags admin-user-controller
+// @ID unsuspend-user
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/user/{id}/unsuspend [post]
+func UnsuspendUser(c *gin.Context) {
+	id := c.Param("id")
+
+	var user models.User
+	result := initializers.DB.First(&user, id)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	result = initializers.DB.Model(&user).Updates(map[string]interface{}{
+		"suspended_at":      nil,
+		"suspended_until":   nil,
+		"suspension_reason": "",
+	})
+
+	if result.Error != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot unsuspend user")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "user unsuspended successfully",
+	})
+}
+
+// ChangeUserRole godoc
+// @Summary ChangeUserRole
+// @Security ApiKeyAuth
+// @Tags admin-user-controller
+// @ID change-user-role
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Param input body ChangeUserRoleInput true "role name"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/user/{id}/role [put]
+func ChangeUserRole(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+	id := c.Param("id")
+
+	var userInput ChangeUserRoleInput
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var role models.Role
+	if err := initializers.DB.First(&role, "name = ?", userInput.Role).Error; err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "role not found")
+		return
+	}
+
+	var user models.User
+	result := initializers.DB.First(&user, id)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if user.ID == authUser.ID {
+		NewErrorResponse(c, http.StatusBadRequest, "you cannot change your own role")
+		return
+	}
+
+	result = initializers.DB.Model(&user).Update("role_id", role.ID)
+
+	if result.Error != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot change user role")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "user role changed successfully",
+	})
+}
+
+// ForcePasswordReset godoc
+// @Summary ForcePasswordReset
+// @Security ApiKeyAuth
+// @Tags admin-user-controller
+// @ID force-password-reset
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/user/{id}/resetpassword [post]
+func ForcePasswordReset(c *gin.Context) {
+	id := c.Param("id")
+
+	var user models.User
+	result := initializers.DB.First(&user, id)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	// the current password stops working until the user sets a new one
+	result = initializers.DB.Model(&user).Update("password", "")
+
+	if result.Error != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot reset password")
+		return
+	}
+
+	if err := helpers.RevokeUserTokens(user.ID); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to revoke tokens")
+		return
+	}
+
+	token, err := helpers.IssuePasswordResetToken(user.ID)
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to create reset token")
+		return
+	}
+
+	if err := sendResetEmail(user.Email, token); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to send reset email")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "password reset email sent",
+	})
+}
+
+// UnlockUser godoc
+// @Summary UnlockUser
+// @Description Clears the failed logins of the account. Throttles of the IP
+// @Description addresses it logged in from stay, as they are shared with
+// @Description other accounts, so logins from a throttled address still wait.
+// @Security ApiKeyAuth
+// @Tags admin-user-controller
+// @ID unlock-user
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /admin/user/{id}/unlock [post]
+func UnlockUser(c *gin.Context) {
+	id := c.Param("id")
+
+	var user models.User
+	result := initializers.DB.First(&user, id)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if err := helpers.ResetLoginFailures(helpers.LoginAccountKey(user.Email)); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot unlock user")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "user unlocked successfully",
+	})
+}
+
+func roleNamesByID() (map[uint]string, error) {
+	var roles []models.Role
+	if err := initializers.DB.Find(&roles).Error; err != nil {
+		return nil, err
+	}
+
+	names := make(map[uint]string, len(roles))
+	for _, role := range roles {
+		names[role.ID] = role.Name
+	}
+	return names, nil
+}
+
+func newAdminUserResponse(user *models.User, roleNames map[uint]string) AdminUserResponse {
+	return AdminUserResponse{
+		ID:                  user.ID,
+		Email:               user.Email,
+		Username:            user.Username,
+		MobilePhone:         user.MobilePhone,
+		BirthDate:           user.BirthDate,
+		Role:                roleNames[user.RoleID],
+		EmailVerified:       user.IsEmailVerified(),
+		TwoFactorEnabled:    user.IsTwoFactorEnabled(),
+		Suspended:           user.IsSuspended(),
+		SuspendedAt:         user.SuspendedAt,
+		SuspendedUntil:      user.SuspendedUntil,
+		SuspensionReason:    user.SuspensionReason,
+		DeletionScheduledAt: user.DeletionScheduledAt,
+		CreatedAt:           user.CreatedAt,
+	}
+}
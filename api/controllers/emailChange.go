@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/diana-gemini/ozinshe/config"
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+	"github.com/diana-gemini/ozinshe/internal/validations"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type ChangeEmailInput struct {
+	Email    string `json:"email" binding:"required,email" example:"user@mail.ru"`
+	Password string `json:"password" binding:"required" example:"123456789"`
+}
+
+// ChangeEmail godoc
+// @Summary ChangeEmail
+// @Security ApiKeyAuth
+// @Tags user-controller
+// @ID change-email
+// @Accept  json
+// @Produce  json
+// @Param input body ChangeEmailInput true "new email and password"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /changeemail [post]
+func ChangeEmail(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	var userInput ChangeEmailInput
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var user models.User
+	result := initializers.DB.First(&user, authUser.ID)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(userInput.Password)); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid password")
+		return
+	}
+
+	if userInput.Email == user.Email {
+		NewErrorResponse(c, http.StatusBadRequest, "new email is the same as the current one")
+		return
+	}
+
+	if validations.IsUniqueValue("users", "email", userInput.Email) {
+		NewErrorResponse(c, http.StatusBadRequest, "email is already exist")
+		return
+	}
+
+	token, err := helpers.GenerateEmailChangeToken(user.ID, user.Email, userInput.Email)
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to create token")
+		return
+	}
+
+	link := config.PublicURL("/changeemail/confirm", url.Values{"token": {token}})
+
+	err = helpers.SendEmail(userInput.Email, "Confirm your new email", "email_change", gin.H{
+		"Link": link,
+	})
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to send confirmation email")
+		return
+	}
+
+	err = helpers.SendEmail(user.Email, "Email change requested", "email_change_notice", gin.H{
+		"NewEmail": userInput.Email,
+	})
+	if err != nil {
+		log.Println("failed to send email change notice:", err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Confirmation email sent to the new address",
+	})
+}
+
+// ConfirmEmailChange godoc
+// @Summary ConfirmEmailChange
+// @Tags user-controller
+// @ID confirm-email-change
+// @Accept  json
+// @Produce  json
+// @Param token query string true "token received in the URL"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /changeemail/confirm [get]
+func ConfirmEmailChange(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		NewErrorResponse(c, http.StatusBadRequest, "token not found in URL")
+		return
+	}
+
+	userID, currentEmail, newEmail, err := helpers.ParseEmailChangeToken(token)
+	if err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid email change token")
+		return
+	}
+
+	var user models.User
+	result := initializers.DB.First(&user, userID)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if user.Email != currentEmail {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid email change token")
+		return
+	}
+
+	err = helpers.ChangeEmail(&user, newEmail)
+	// the email may have been taken since the change was requested
+	if errors.Is(err, helpers.ErrEmailTaken) || validations.IsDuplicateKey(err) {
+		NewErrorResponse(c, http.StatusConflict, "email already in use")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "email is not changed")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Email successfully changed",
+	})
+}
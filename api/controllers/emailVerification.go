@@ -0,0 +1,154 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/config"
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+const defaultVerificationResendInterval = time.Minute
+
+type ResendVerification struct {
+	Email string `json:"email" binding:"required,email" example:"user@mail.ru"`
+}
+
+// VerifyEmail godoc
+// @Summary VerifyEmail
+// @Tags auth-controller
+// @ID verify-email
+// @Accept  json
+// @Produce  json
+// @Param token query string true "token received in the URL"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /verifyemail [get]
+func VerifyEmail(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		NewErrorResponse(c, http.StatusBadRequest, "token not found in URL")
+		return
+	}
+
+	userID, email, err := helpers.ParseEmailVerificationToken(token)
+	if err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid verification token")
+		return
+	}
+
+	var user models.User
+	result := initializers.DB.First(&user, userID)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if user.Email != email {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid verification token")
+		return
+	}
+
+	if !user.IsEmailVerified() {
+		result = initializers.DB.Model(&user).Update("email_verified_at", time.Now())
+
+		if result.Error != nil {
+			NewErrorResponse(c, http.StatusInternalServerError, "email is not verified")
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Email successfully verified",
+	})
+}
+
+// ResendVerificationEmail godoc
+// @Summary ResendVerificationEmail
+// @Tags auth-controller
+// @ID resend-verification-email
+// @Accept  json
+// @Produce  json
+// @Param email body ResendVerification true "email"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /verifyemail/resend [post]
+func ResendVerificationEmail(c *gin.Context) {
+	var userInput ResendVerification
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var user models.User
+	initializers.DB.First(&user, "email = ?", userInput.Email)
+
+	if user.ID == 0 {
+		NewErrorResponse(c, http.StatusBadRequest, "user not found")
+		return
+	}
+
+	if user.IsEmailVerified() {
+		NewErrorResponse(c, http.StatusBadRequest, "email is already verified")
+		return
+	}
+
+	err := sendVerificationEmail(&user)
+	if errors.Is(err, errVerificationThrottled) {
+		NewErrorResponse(c, http.StatusTooManyRequests, "verification email was sent recently")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to send verification email")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Verification email sent",
+	})
+}
+
+var errVerificationThrottled = errors.New("verification email was sent recently")
+
+func sendVerificationEmail(user *models.User) error {
+	interval, err := time.ParseDuration(os.Getenv("EMAIL_VERIFICATION_RESEND_INTERVAL"))
+	if err != nil {
+		interval = defaultVerificationResendInterval
+	}
+
+	now := time.Now()
+	result := initializers.DB.Model(&models.User{}).
+		Where("id = ? AND (verification_sent_at IS NULL OR verification_sent_at < ?)", user.ID, now.Add(-interval)).
+		Update("verification_sent_at", now)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errVerificationThrottled
+	}
+
+	token, err := helpers.GenerateEmailVerificationToken(user.ID, user.Email)
+	if err != nil {
+		return err
+	}
+
+	link := config.PublicURL("/verifyemail", url.Values{"token": {token}})
+
+	return helpers.SendEmail(user.Email, "Confirm your email", "email_verification", gin.H{
+		"Link": link,
+	})
+}
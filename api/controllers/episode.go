@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// releaseDateLayout is the format of episode release dates.
+const releaseDateLayout = "2006-01-02"
+
+type EpisodeResponse struct {
+	ID            uint
+	SeasonID      uint
+	EpisodeNumber int
+	Position      int
+	Title         string
+	Description   string
+	Duration      int // minutes
+	Thumbnail     string
+	ReleaseDate   string `example:"2024-01-15"`
+	Link          string
+}
+
+func newEpisodeResponse(video *models.Video) EpisodeResponse {
+	response := EpisodeResponse{
+		ID:            video.ID,
+		SeasonID:      video.SeasonID,
+		EpisodeNumber: video.EpisodeNumber,
+		Position:      video.Position,
+		Title:         video.Title,
+		Description:   video.Description,
+		Duration:      video.Duration,
+		Thumbnail:     video.Thumbnail,
+		Link:          video.Link,
+	}
+	if video.ReleaseDate != nil {
+		response.ReleaseDate = video.ReleaseDate.Format(releaseDateLayout)
+	}
+
+	return response
+}
+
+// orderEpisodes lists the episodes of a season in their display order.
+func orderEpisodes(db *gorm.DB) *gorm.DB {
+	return db.Order("position, episode_number, id")
+}
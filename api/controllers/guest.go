@@ -0,0 +1,25 @@
+package controllers
+
+import (
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// preloadVideos loads the episodes of the preloaded seasons, in the order of
+// their numbers. Guests browsing the public catalog get the episodes without
+// their playback links.
+func preloadVideos(c *gin.Context) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Order("number")
+
+		if helpers.GetOptionalAuthUser(c) == nil {
+			return db.Preload("Videos", func(db *gorm.DB) *gorm.DB {
+				return orderEpisodes(db).Omit("link")
+			})
+		}
+
+		return db.Preload("Videos", orderEpisodes)
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/diana-gemini/ozinshe/internal/models"
 
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
 var limitOfMovie = 5
@@ -19,6 +18,8 @@ var limitOfMovie = 5
 // @ID home
 // @Accept  json
 // @Produce  json
+// @Param lang query string false "kk, ru or en"
+// @Param Accept-Language header string false "used without lang"
 // @Success 200 {integer} integer 1
 // @Failure 400,404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -26,11 +27,9 @@ var limitOfMovie = 5
 // @Router /home [get]
 func Home(c *gin.Context) {
 	var trendMovies []models.Movie
-	trendResult := initializers.DB.Preload("Categories").
+	trendResult := initializers.DB.Scopes(ageRestriction(c)).Preload("Categories").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).Order("count_of_watch desc").
+		Preload("Seasons", preloadVideos(c)).Order("count_of_watch desc").
 		Limit(limitOfMovie).
 		Find(&trendMovies)
 
@@ -39,11 +38,9 @@ func Home(c *gin.Context) {
 	}
 
 	var newMovies []models.Movie
-	newResult := initializers.DB.Preload("Categories").
+	newResult := initializers.DB.Scopes(ageRestriction(c)).Preload("Categories").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).Order("created_at desc").
+		Preload("Seasons", preloadVideos(c)).Order("created_at desc").
 		Limit(limitOfMovie).
 		Find(&newMovies)
 
@@ -59,11 +56,10 @@ func Home(c *gin.Context) {
 
 	var telehikayaMovies []models.Movie
 	typesResult = initializers.DB.
+		Scopes(ageRestriction(c)).
 		Preload("Categories").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).Where("type_id = ?", types.ID).
+		Preload("Seasons", preloadVideos(c)).Where("type_id = ?", types.ID).
 		Order("created_at desc").
 		Limit(limitOfMovie).
 		Find(&telehikayaMovies)
@@ -71,12 +67,12 @@ func Home(c *gin.Context) {
 		NewErrorResponse(c, http.StatusNotFound, "telehikaya not found")
 	}
 
-	hororMovies, errhororMovies := getMoviesByCategory("Horor")
+	hororMovies, errhororMovies := getMoviesByCategory(c, "Horor")
 	if errhororMovies != nil {
 		NewErrorResponse(c, http.StatusNotFound, "horor not found")
 	}
 
-	animeMovies, errAnimeMovies := getMoviesByCategory("Anime")
+	animeMovies, errAnimeMovies := getMoviesByCategory(c, "Anime")
 	if errAnimeMovies != nil {
 		NewErrorResponse(c, http.StatusNotFound, "anime not found")
 	}
@@ -93,6 +89,27 @@ func Home(c *gin.Context) {
 		NewErrorResponse(c, http.StatusNotFound, "age category not found")
 	}
 
+	for _, movies := range [][]models.Movie{trendMovies, newMovies, telehikayaMovies, hororMovies, animeMovies} {
+		if err := localizeMovies(c, movies); err != nil {
+			NewErrorResponse(c, http.StatusInternalServerError, "cannot localize movies")
+			return
+		}
+	}
+
+	categories := make([]*models.Category, len(category))
+	for i := range category {
+		categories[i] = &category[i]
+	}
+	if err := localizeCategories(c, categories); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize categories")
+		return
+	}
+
+	if err := localizeAgeCategories(c, ageCategory); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize age categories")
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"TrendMovies": trendMovies,
 		"NewMovies":   newMovies,
@@ -104,17 +121,16 @@ func Home(c *gin.Context) {
 	})
 }
 
-func getMoviesByCategory(categoryName string) ([]models.Movie, error) {
+func getMoviesByCategory(c *gin.Context, categoryName string) ([]models.Movie, error) {
 	var movies []models.Movie
 	result := initializers.DB.
+		Scopes(ageRestriction(c)).
 		Joins("JOIN movie_category ON movies.id = movie_category.movie_id").
 		Joins("JOIN categories ON movie_category.category_id = categories.id").
 		Where("categories.category_name = ?", categoryName).
 		Preload("Categories").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).Limit(limitOfMovie).
+		Preload("Seasons", preloadVideos(c)).Limit(limitOfMovie).
 		Find(&movies)
 
 	if err := result.Error; err != nil {
@@ -7,7 +7,6 @@ import (
 	"github.com/diana-gemini/ozinshe/internal/models"
 
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
 // GetTrends godoc
@@ -17,6 +16,8 @@ import (
 // @ID get-trends
 // @Accept json
 // @Produce json
+// @Param lang query string false "kk, ru or en"
+// @Param Accept-Language header string false "used without lang"
 // @Success 200 {integer} integer 1
 // @Failure 400,404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -24,16 +25,19 @@ import (
 // @Router /trends [get]
 func GetTrends(c *gin.Context) {
 	var movies []models.Movie
-	result := initializers.DB.Preload("Categories").
+	result := initializers.DB.Scopes(ageRestriction(c)).Preload("Categories").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).Order("count_of_watch desc").Find(&movies)
+		Preload("Seasons", preloadVideos(c)).Order("count_of_watch desc").Find(&movies)
 	if err := result.Error; err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "trend movies not found")
 		return
 	}
 
+	if err := localizeMovies(c, movies); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize movies")
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"Movies": movies,
 	})
@@ -46,6 +50,8 @@ func GetTrends(c *gin.Context) {
 // @ID get-new-projects
 // @Accept json
 // @Produce json
+// @Param lang query string false "kk, ru or en"
+// @Param Accept-Language header string false "used without lang"
 // @Success 200 {integer} integer 1
 // @Failure 400,404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -53,16 +59,19 @@ func GetTrends(c *gin.Context) {
 // @Router /newprojects [get]
 func GetNewprojects(c *gin.Context) {
 	var movies []models.Movie
-	result := initializers.DB.Preload("Categories").
+	result := initializers.DB.Scopes(ageRestriction(c)).Preload("Categories").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).Order("created_at desc").Find(&movies)
+		Preload("Seasons", preloadVideos(c)).Order("created_at desc").Find(&movies)
 	if err := result.Error; err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "new projects not found")
 		return
 	}
 
+	if err := localizeMovies(c, movies); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize movies")
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"Movies": movies,
 	})
@@ -75,6 +84,8 @@ func GetNewprojects(c *gin.Context) {
 // @ID get-telehikaya
 // @Accept json
 // @Produce json
+// @Param lang query string false "kk, ru or en"
+// @Param Accept-Language header string false "used without lang"
 // @Success 200 {integer} integer 1
 // @Failure 400,404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -90,11 +101,10 @@ func GetTelehikaya(c *gin.Context) {
 
 	var movies []models.Movie
 	result = initializers.DB.
+		Scopes(ageRestriction(c)).
 		Preload("Categories").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).Where("type_id = ?", types.ID).
+		Preload("Seasons", preloadVideos(c)).Where("type_id = ?", types.ID).
 		Order("created_at desc").
 		Find(&movies)
 
@@ -103,6 +113,11 @@ func GetTelehikaya(c *gin.Context) {
 		return
 	}
 
+	if err := localizeMovies(c, movies); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize movies")
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"Movies": movies,
 	})
@@ -115,6 +130,8 @@ func GetTelehikaya(c *gin.Context) {
 // @ID get-horor
 // @Accept json
 // @Produce json
+// @Param lang query string false "kk, ru or en"
+// @Param Accept-Language header string false "used without lang"
 // @Success 200 {integer} integer 1
 // @Failure 400,404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -123,20 +140,24 @@ func GetTelehikaya(c *gin.Context) {
 func Horor(c *gin.Context) {
 	var movies []models.Movie
 	result := initializers.DB.
+		Scopes(ageRestriction(c)).
 		Joins("JOIN movie_category ON movies.id = movie_category.movie_id").
 		Joins("JOIN categories ON movie_category.category_id = categories.id").
 		Where("categories.category_name = ?", "Horor").
 		Preload("Categories").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).Find(&movies)
+		Preload("Seasons", preloadVideos(c)).Find(&movies)
 
 	if err := result.Error; err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "horor not found")
 		return
 	}
 
+	if err := localizeMovies(c, movies); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize movies")
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"Horor": movies,
 	})
@@ -149,6 +170,8 @@ func Horor(c *gin.Context) {
 // @ID get-anime
 // @Accept json
 // @Produce json
+// @Param lang query string false "kk, ru or en"
+// @Param Accept-Language header string false "used without lang"
 // @Success 200 {integer} integer 1
 // @Failure 400,404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -157,20 +180,24 @@ func Horor(c *gin.Context) {
 func Anime(c *gin.Context) {
 	var movies []models.Movie
 	result := initializers.DB.
+		Scopes(ageRestriction(c)).
 		Joins("JOIN movie_category ON movies.id = movie_category.movie_id").
 		Joins("JOIN categories ON movie_category.category_id = categories.id").
 		Where("categories.category_name = ?", "Anime").
 		Preload("Categories").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).Find(&movies)
+		Preload("Seasons", preloadVideos(c)).Find(&movies)
 
 	if err := result.Error; err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "anime not found")
 		return
 	}
 
+	if err := localizeMovies(c, movies); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize movies")
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"Anime": movies,
 	})
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"mime/multipart"
 	"net/http"
@@ -44,3 +45,25 @@ func ImageUpload(c *gin.Context, images []*multipart.FileHeader) ([]string, erro
 
 	return imageURLs, nil
 }
+
+// uploadOptionalImage uploads the image sent in the form field. It returns an
+// empty link when no image was sent, and reports false after writing the
+// error response.
+func uploadOptionalImage(c *gin.Context, field string) (string, bool) {
+	image, err := c.FormFile(field)
+	if errors.Is(err, http.ErrMissingFile) {
+		return "", true
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "failed to parse form")
+		return "", false
+	}
+
+	imageURL, err := ImageUpload(c, []*multipart.FileHeader{image})
+	if err != nil || len(imageURL) < 1 {
+		NewErrorResponse(c, http.StatusBadRequest, "cannot file upload")
+		return "", false
+	}
+
+	return imageURL[0], true
+}
@@ -0,0 +1,21 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// JWKS godoc
+// @Summary JWKS
+// @Tags auth-controller
+// @ID jwks
+// @Produce  json
+// @Success 200 {object} jwtkeys.JWKS
+// @Router /.well-known/jwks.json [get]
+func JWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, initializers.SigningKeys.JWKS())
+}
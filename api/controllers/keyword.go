@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type KeywordResponse struct {
+	ID   uint
+	Name string
+}
+
+type KeywordList struct {
+	Keywords []KeywordResponse
+	Page     int
+	Limit    int
+	Total    int64
+}
+
+// GetKeywords godoc
+// @Summary GetKeywords
+// @Tags keyword-controller
+// @ID get-keywords
+// @Accept  json
+// @Produce  json
+// @Param page query integer false "page, starting from 1"
+// @Param limit query integer false "keywords per page"
+// @Param search query string false "start of the keyword"
+// @Success 200 {object} KeywordList
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /keywords [get]
+func GetKeywords(c *gin.Context) {
+	page, limit, ok := parsePagination(c)
+	if !ok {
+		return
+	}
+
+	query := initializers.DB.Model(&models.Keyword{})
+
+	if search := helpers.NormalizeKeyword(c.Query("search")); search != "" {
+		query = query.Where("name LIKE ? ESCAPE '\\'", helpers.EscapeLike(search)+"%")
+	}
+
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get keywords")
+		return
+	}
+
+	response := []KeywordResponse{}
+	err := query.Select("id, name").Order("name").Offset((page - 1) * limit).Limit(limit).Scan(&response).Error
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get keywords")
+		return
+	}
+
+	c.JSON(http.StatusOK, KeywordList{
+		Keywords: response,
+		Page:     page,
+		Limit:    limit,
+		Total:    total,
+	})
+}
+
+// GetKeywordMovies godoc
+// @Summary GetKeywordMovies
+// @Tags keyword-controller
+// @ID get-keyword-movies
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "keyword id"
+// @Param lang query string false "kk, ru or en"
+// @Param Accept-Language header string false "used without lang"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /keywords/{id} [get]
+func GetKeywordMovies(c *gin.Context) {
+	var keyword models.Keyword
+	if err := initializers.DB.First(&keyword, c.Param("id")).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "keyword not found")
+		return
+	}
+
+	var movies []models.Movie
+	result := initializers.DB.Scopes(ageRestriction(c)).
+		Joins("JOIN movie_keywords ON movie_keywords.movie_id = movies.id").
+		Where("movie_keywords.keyword_id = ?", keyword.ID).
+		Preload("Categories").
+		Preload("Keywords").
+		Preload("Screenshots").
+		Preload("Seasons", preloadVideos(c)).
+		Find(&movies)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	if err := localizeMovies(c, movies); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize movies")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"Keyword": KeywordResponse{ID: keyword.ID, Name: keyword.Name},
+		"Movies":  movies,
+	})
+}
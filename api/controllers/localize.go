@@ -0,0 +1,237 @@
+package controllers
+
+import (
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// translationIndex holds the translations of one field by entry and locale.
+type translationIndex map[uint]map[string]string
+
+func (index translationIndex) add(id uint, locale, text string) {
+	if text == "" {
+		return
+	}
+	if index[id] == nil {
+		index[id] = map[string]string{}
+	}
+	index[id][locale] = text
+}
+
+// lookup returns the text of the first locale the entry is translated to.
+func (index translationIndex) lookup(id uint, locales []string) (string, bool) {
+	for _, locale := range locales {
+		if text, ok := index[id][locale]; ok {
+			return text, true
+		}
+	}
+	return "", false
+}
+
+// localizeMovies shows the movies, their categories, type and age category in
+// the locales of the request.
+func localizeMovies(c *gin.Context, movies []models.Movie) error {
+	if len(movies) == 0 {
+		return nil
+	}
+
+	locales := helpers.RequestLocales(c)
+
+	ids := make([]uint, len(movies))
+	for i := range movies {
+		ids[i] = movies[i].ID
+	}
+
+	var translations []models.MovieTranslation
+	if err := initializers.DB.Where("movie_id IN ? AND locale IN ?", ids, locales).Find(&translations).Error; err != nil {
+		return err
+	}
+
+	names, descriptions := translationIndex{}, translationIndex{}
+	for _, translation := range translations {
+		names.add(translation.MovieID, translation.Locale, translation.NameOfProject)
+		descriptions.add(translation.MovieID, translation.Locale, translation.Description)
+	}
+
+	var categories []*models.Category
+	for i := range movies {
+		if name, ok := names.lookup(movies[i].ID, locales); ok {
+			movies[i].NameOfProject = name
+		}
+		if description, ok := descriptions.lookup(movies[i].ID, locales); ok {
+			movies[i].Description = description
+		}
+
+		for j := range movies[i].Categories {
+			categories = append(categories, &movies[i].Categories[j])
+		}
+	}
+
+	if err := localizeCategories(c, categories); err != nil {
+		return err
+	}
+
+	return localizeMovieLabels(c, movies)
+}
+
+// localizeMovieLabels fills the type and age category names of the movies.
+func localizeMovieLabels(c *gin.Context, movies []models.Movie) error {
+	typeIDs := make([]uint, len(movies))
+	ageCategoryIDs := make([]uint, len(movies))
+	for i := range movies {
+		typeIDs[i] = movies[i].TypeID
+		ageCategoryIDs[i] = movies[i].AgeCategoryID
+	}
+
+	var types []models.Type
+	if err := initializers.DB.Unscoped().Where("id IN ?", typeIDs).Find(&types).Error; err != nil {
+		return err
+	}
+	if err := localizeTypes(c, types); err != nil {
+		return err
+	}
+
+	var ageCategories []models.AgeCategory
+	if err := initializers.DB.Unscoped().Where("id IN ?", ageCategoryIDs).Find(&ageCategories).Error; err != nil {
+		return err
+	}
+	if err := localizeAgeCategories(c, ageCategories); err != nil {
+		return err
+	}
+
+	typeNames := map[uint]string{}
+	for _, typeOfProject := range types {
+		typeNames[typeOfProject.ID] = typeOfProject.TypeName
+	}
+
+	ageCategoryNames := map[uint]string{}
+	for _, ageCategory := range ageCategories {
+		ageCategoryNames[ageCategory.ID] = ageCategory.AgeCategoryName
+	}
+
+	for i := range movies {
+		movies[i].TypeName = typeNames[movies[i].TypeID]
+		movies[i].AgeCategoryName = ageCategoryNames[movies[i].AgeCategoryID]
+	}
+
+	return nil
+}
+
+// localizeMovie shows a single movie in the locales of the request.
+func localizeMovie(c *gin.Context, movie *models.Movie) error {
+	movies := []models.Movie{*movie}
+	if err := localizeMovies(c, movies); err != nil {
+		return err
+	}
+
+	*movie = movies[0]
+	return nil
+}
+
+func localizeCategories(c *gin.Context, categories []*models.Category) error {
+	if len(categories) == 0 {
+		return nil
+	}
+
+	locales := helpers.RequestLocales(c)
+
+	ids := make([]uint, len(categories))
+	for i, category := range categories {
+		ids[i] = category.ID
+	}
+
+	var translations []models.CategoryTranslation
+	if err := initializers.DB.Where("category_id IN ? AND locale IN ?", ids, locales).Find(&translations).Error; err != nil {
+		return err
+	}
+
+	names := translationIndex{}
+	for _, translation := range translations {
+		names.add(translation.CategoryID, translation.Locale, translation.CategoryName)
+	}
+
+	for _, category := range categories {
+		if name, ok := names.lookup(category.ID, locales); ok {
+			category.CategoryName = name
+		}
+	}
+
+	return nil
+}
+
+func localizeTypes(c *gin.Context, types []models.Type) error {
+	if len(types) == 0 {
+		return nil
+	}
+
+	locales := helpers.RequestLocales(c)
+
+	ids := make([]uint, len(types))
+	for i := range types {
+		ids[i] = types[i].ID
+	}
+
+	var translations []models.TypeTranslation
+	if err := initializers.DB.Where("type_id IN ? AND locale IN ?", ids, locales).Find(&translations).Error; err != nil {
+		return err
+	}
+
+	names := translationIndex{}
+	for _, translation := range translations {
+		names.add(translation.TypeID, translation.Locale, translation.TypeName)
+	}
+
+	for i := range types {
+		if name, ok := names.lookup(types[i].ID, locales); ok {
+			types[i].TypeName = name
+		}
+	}
+
+	return nil
+}
+
+// localizeAgeCategory shows a single age category in the locales of the
+// request.
+func localizeAgeCategory(c *gin.Context, ageCategory *models.AgeCategory) error {
+	ageCategories := []models.AgeCategory{*ageCategory}
+	if err := localizeAgeCategories(c, ageCategories); err != nil {
+		return err
+	}
+
+	*ageCategory = ageCategories[0]
+	return nil
+}
+
+func localizeAgeCategories(c *gin.Context, ageCategories []models.AgeCategory) error {
+	if len(ageCategories) == 0 {
+		return nil
+	}
+
+	locales := helpers.RequestLocales(c)
+
+	ids := make([]uint, len(ageCategories))
+	for i := range ageCategories {
+		ids[i] = ageCategories[i].ID
+	}
+
+	var translations []models.AgeCategoryTranslation
+	if err := initializers.DB.Where("age_category_id IN ? AND locale IN ?", ids, locales).Find(&translations).Error; err != nil {
+		return err
+	}
+
+	names := translationIndex{}
+	for _, translation := range translations {
+		names.add(translation.AgeCategoryID, translation.Locale, translation.AgeCategoryName)
+	}
+
+	for i := range ageCategories {
+		if name, ok := names.lookup(ageCategories[i].ID, locales); ok {
+			ageCategories[i].AgeCategoryName = name
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// movieSorts maps the sort query parameter to the order it selects.
+var movieSorts = map[string]string{
+	"year":     "movies.year, movies.id",
+	"-year":    "movies.year DESC, movies.id",
+	"runtime":  "movies.runtime, movies.id",
+	"-runtime": "movies.runtime DESC, movies.id",
+}
+
+// filterMovies applies the year, runtime, keyword and sort query parameters
+// to a movie query. It writes the error response itself and reports false
+// when a parameter is invalid.
+func filterMovies(c *gin.Context, query *gorm.DB) (*gorm.DB, bool) {
+	numberFilters := []struct {
+		param     string
+		condition string
+	}{
+		{"yearfrom", "movies.year >= ?"},
+		{"yearto", "movies.year <= ?"},
+		{"runtimemin", "movies.runtime >= ?"},
+		{"runtimemax", "movies.runtime <= ?"},
+	}
+
+	for _, filter := range numberFilters {
+		value := c.Query(filter.param)
+		if value == "" {
+			continue
+		}
+
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			NewErrorResponse(c, http.StatusBadRequest, "invalid "+filter.param)
+			return nil, false
+		}
+		query = query.Where(filter.condition, number)
+	}
+
+	if value := c.Query("keyword"); value != "" {
+		query = query.Where("movies.id IN (?)", initializers.DB.Model(&models.Keyword{}).
+			Select("movie_keywords.movie_id").
+			Joins("JOIN movie_keywords ON movie_keywords.keyword_id = keywords.id").
+			Where("keywords.name = ?", helpers.NormalizeKeyword(value)))
+	}
+
+	if value := c.Query("sort"); value != "" {
+		order, ok := movieSorts[value]
+		if !ok {
+			NewErrorResponse(c, http.StatusBadRequest, "invalid sort")
+			return nil, false
+		}
+		query = query.Order(order)
+	}
+
+	return query, true
+}
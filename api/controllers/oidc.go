@@ -0,0 +1,254 @@
+package controllers
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+	"github.com/diana-gemini/ozinshe/internal/oidc"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const oauthStateTTL = 10 * time.Minute
+
+// oauthStateCookie ties a login to the browser that started it, so that a
+// callback with someone else's state is refused.
+const oauthStateCookie = "oidc_state"
+
+func setOAuthStateCookie(c *gin.Context, state string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oauthStateCookie, state, maxAge, "/oidc", "", c.Request.TLS != nil, true)
+}
+
+// OIDCLogin godoc
+// @Summary OIDCLogin
+// @Tags auth-controller
+// @ID oidc-login
+// @Produce  json
+// @Success 302
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /oidc/login [get]
+func OIDCLogin(c *gin.Context) {
+	provider, err := oidc.FromEnv(c.Request.Context())
+	if errors.Is(err, oidc.ErrNotConfigured) {
+		NewErrorResponse(c, http.StatusNotFound, "oidc login is not configured")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusBadGateway, "oidc provider is not available")
+		return
+	}
+
+	state, err := oidc.NewState()
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to create state")
+		return
+	}
+
+	nonce, err := oidc.NewState()
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to create nonce")
+		return
+	}
+
+	codeVerifier, err := oidc.NewCodeVerifier()
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to create code verifier")
+		return
+	}
+
+	err = initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("expires_at < ?", time.Now()).Delete(&models.OAuthState{}).Error; err != nil {
+			return err
+		}
+
+		oauthState := models.OAuthState{
+			State:        state,
+			Nonce:        nonce,
+			CodeVerifier: codeVerifier,
+			ExpiresAt:    time.Now().Add(oauthStateTTL),
+		}
+
+		return tx.Create(&oauthState).Error
+	})
+
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to save state")
+		return
+	}
+
+	setOAuthStateCookie(c, state, int(oauthStateTTL.Seconds()))
+	c.Redirect(http.StatusFound, provider.AuthCodeURL(state, nonce, codeVerifier))
+}
+
+// OIDCCallback godoc
+// @Summary OIDCCallback
+// @Tags auth-controller
+// @ID oidc-callback
+// @Produce  json
+// @Param code query string true "authorization code"
+// @Param state query string true "state"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /oidc/callback [get]
+func OIDCCallback(c *gin.Context) {
+	if errorCode := c.Query("error"); errorCode != "" {
+		NewErrorResponse(c, http.StatusBadRequest, "oidc login failed: "+errorCode)
+		return
+	}
+
+	code := c.Query("code")
+	state := c.Query("state")
+	if code == "" || state == "" {
+		NewErrorResponse(c, http.StatusBadRequest, "code or state not found in URL")
+		return
+	}
+
+	cookieState, err := c.Cookie(oauthStateCookie)
+	setOAuthStateCookie(c, "", -1)
+	if err != nil || subtle.ConstantTimeCompare([]byte(cookieState), []byte(state)) != 1 {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid state")
+		return
+	}
+
+	provider, err := oidc.FromEnv(c.Request.Context())
+	if err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "oidc login is not configured")
+		return
+	}
+
+	var oauthState models.OAuthState
+	result := initializers.DB.Where("state = ?", state).First(&oauthState)
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid state")
+		return
+	}
+
+	result = initializers.DB.Unscoped().Where("id = ?", oauthState.ID).Delete(&models.OAuthState{})
+	if result.Error != nil || result.RowsAffected != 1 || time.Now().After(oauthState.ExpiresAt) {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid state")
+		return
+	}
+
+	tokens, err := provider.Exchange(c.Request.Context(), code, oauthState.CodeVerifier)
+	if err != nil {
+		NewErrorResponse(c, http.StatusBadGateway, "failed to exchange code")
+		return
+	}
+
+	claims, err := provider.VerifyIDToken(c.Request.Context(), tokens.IDToken, oauthState.Nonce)
+	if err != nil {
+		NewErrorResponse(c, http.StatusUnauthorized, "invalid id token")
+		return
+	}
+
+	user, err := findOrCreateOIDCUser(provider.Name, claims)
+	if errors.Is(err, errEmailNotVerified) {
+		NewErrorResponse(c, http.StatusForbidden, "email is not verified by the provider")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	respondWithLogin(c, user)
+}
+
+var errEmailNotVerified = errors.New("email is not verified by the provider")
+
+// findOrCreateOIDCUser returns the user linked to the external identity. The
+// identity is linked to an existing user, or a new user is created, only by
+// an email the provider has verified.
+func findOrCreateOIDCUser(providerName string, claims *oidc.Claims) (*models.User, error) {
+	var user models.User
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		var identity models.UserIdentity
+		err := tx.Where("provider = ? AND subject = ?", providerName, claims.Subject).First(&identity).Error
+		if err == nil {
+			return tx.First(&user, identity.UserID).Error
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if claims.Email == "" || !claims.EmailVerified {
+			return errEmailNotVerified
+		}
+
+		err = tx.Where("email = ?", claims.Email).First(&user).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if user, err = newOIDCUser(tx, claims); err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+
+		if !user.IsEmailVerified() {
+			if err := tx.Model(&user).Update("email_verified_at", time.Now()).Error; err != nil {
+				return err
+			}
+		}
+
+		identity = models.UserIdentity{
+			UserID:   user.ID,
+			Provider: providerName,
+			Subject:  claims.Subject,
+		}
+
+		return tx.Create(&identity).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func newOIDCUser(tx *gorm.DB, claims *oidc.Claims) (models.User, error) {
+	var role models.Role
+	if err := tx.First(&role, "name = ?", models.RoleUser).Error; err != nil {
+		return models.User{}, err
+	}
+
+	// the account has no usable password until the user resets it
+	randomPassword, err := helpers.GenerateRandomToken(32)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	hashPassword, err := bcrypt.GenerateFromPassword([]byte(randomPassword), 10)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	now := time.Now()
+	user := models.User{
+		Email:           claims.Email,
+		Password:        string(hashPassword),
+		RoleID:          role.ID,
+		Username:        claims.Name,
+		EmailVerifiedAt: &now,
+	}
+
+	if err := tx.Create(&user).Error; err != nil {
+		return user, err
+	}
+
+	err = helpers.CreateDefaultProfile(tx, &user)
+	return user, err
+}
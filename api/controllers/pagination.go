@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// parsePagination reads the page and limit query parameters. It writes the
+// error response itself and reports false when they are invalid.
+func parsePagination(c *gin.Context) (int, int, bool) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid page")
+		return 0, 0, false
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultPageLimit)))
+	if err != nil || limit < 1 || limit > maxPageLimit {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid limit")
+		return 0, 0, false
+	}
+
+	return page, limit, true
+}
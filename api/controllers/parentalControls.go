@@ -0,0 +1,413 @@
+package controllers
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+type ParentalControlsResponse struct {
+	Enabled         bool
+	AgeCategoryID   uint
+	AgeCategoryName string
+	UnlockedUntil   *time.Time
+}
+
+type SetParentalControlsInput struct {
+	AgeCategoryID uint   `json:"ageCategoryId" binding:"required" example:"1"`
+	Pin           string `json:"pin" binding:"required,numeric,min=4,max=8" example:"1234"`
+	// Password is needed to enable parental controls, and CurrentPin or
+	// Password to change them
+	CurrentPin string `json:"currentPin" example:"1234"`
+	Password   string `json:"password" example:"123456789"`
+}
+
+type DisableParentalControlsInput struct {
+	Pin      string `json:"pin" example:"1234"`
+	Password string `json:"password" example:"123456789"`
+}
+
+type ParentalPinInput struct {
+	Pin string `json:"pin" binding:"required" example:"1234"`
+}
+
+// GetParentalControls godoc
+// @Summary GetParentalControls
+// @Security ApiKeyAuth
+// @Tags parental-controls-controller
+// @ID get-parental-controls
+// @Accept  json
+// @Produce  json
+// @Param lang query string false "kk, ru or en"
+// @Param Accept-Language header string false "used without lang"
+// @Success 200 {object} ParentalControlsResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /parentalcontrols [get]
+func GetParentalControls(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	var user models.User
+	if err := initializers.DB.First(&user, authUser.ID).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	response := ParentalControlsResponse{}
+
+	if user.ParentalAgeCategoryID != nil {
+		var ageCategory models.AgeCategory
+		if err := initializers.DB.Unscoped().First(&ageCategory, *user.ParentalAgeCategoryID).Error; err != nil {
+			NewErrorResponse(c, http.StatusInternalServerError, "cannot get parental controls")
+			return
+		}
+		if err := localizeAgeCategory(c, &ageCategory); err != nil {
+			NewErrorResponse(c, http.StatusInternalServerError, "cannot localize age categories")
+			return
+		}
+
+		response.Enabled = true
+		response.AgeCategoryID = ageCategory.ID
+		response.AgeCategoryName = ageCategory.AgeCategoryName
+
+		var session models.Session
+		if err := initializers.DB.First(&session, authUser.SessionID).Error; err == nil &&
+			session.ParentalUnlockedUntil != nil && session.ParentalUnlockedUntil.After(time.Now()) {
+			response.UnlockedUntil = session.ParentalUnlockedUntil
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SetParentalControls godoc
+// @Summary SetParentalControls
+// @Security ApiKeyAuth
+// @Tags parental-controls-controller
+// @ID set-parental-controls
+// @Accept  json
+// @Produce  json
+// @Param input body SetParentalControlsInput true "maximum age category, PIN, and the password or current PIN"
+// @Param lang query string false "kk, ru or en"
+// @Param Accept-Language header string false "used without lang"
+// @Success 200 {object} ParentalControlsResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /parentalcontrols [put]
+func SetParentalControls(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	if !checkParentalProfile(c, authUser.ProfileID) {
+		return
+	}
+
+	var userInput SetParentalControlsInput
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var user models.User
+	if err := initializers.DB.First(&user, authUser.ID).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if user.ParentalAgeCategoryID == nil && !checkParentalSecret(c, &user, "", userInput.Password, "invalid password") {
+		return
+	}
+	if user.ParentalAgeCategoryID != nil && !checkParentalSecret(c, &user, userInput.CurrentPin, userInput.Password, "invalid pin") {
+		return
+	}
+
+	err := helpers.SetParentalControls(&user, userInput.AgeCategoryID, userInput.Pin)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		NewErrorResponse(c, http.StatusNotFound, "age category not found")
+		return
+	}
+	if errors.Is(err, helpers.ErrAgeCategoryNotRated) {
+		NewErrorResponse(c, http.StatusBadRequest, "age category has no minimum age")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot set parental controls")
+		return
+	}
+
+	var ageCategory models.AgeCategory
+	initializers.DB.First(&ageCategory, userInput.AgeCategoryID)
+	if err := localizeAgeCategory(c, &ageCategory); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize age categories")
+		return
+	}
+
+	c.JSON(http.StatusOK, ParentalControlsResponse{
+		Enabled:         true,
+		AgeCategoryID:   ageCategory.ID,
+		AgeCategoryName: ageCategory.AgeCategoryName,
+	})
+}
+
+// DisableParentalControls godoc
+// @Summary DisableParentalControls
+// @Security ApiKeyAuth
+// @Tags parental-controls-controller
+// @ID disable-parental-controls
+// @Accept  json
+// @Produce  json
+// @Param input body DisableParentalControlsInput true "PIN or account password"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /parentalcontrols [delete]
+func DisableParentalControls(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	if !checkParentalProfile(c, authUser.ProfileID) {
+		return
+	}
+
+	var userInput DisableParentalControlsInput
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var user models.User
+	if err := initializers.DB.First(&user, authUser.ID).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if user.ParentalAgeCategoryID == nil {
+		NewErrorResponse(c, http.StatusBadRequest, "parental controls are not enabled")
+		return
+	}
+
+	if !checkParentalSecret(c, &user, userInput.Pin, userInput.Password, "invalid pin") {
+		return
+	}
+
+	if err := helpers.DisableParentalControls(&user); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot disable parental controls")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "parental controls disabled",
+	})
+}
+
+// UnlockParentalControls godoc
+// @Summary UnlockParentalControls
+// @Security ApiKeyAuth
+// @Tags parental-controls-controller
+// @ID unlock-parental-controls
+// @Accept  json
+// @Produce  json
+// @Param input body ParentalPinInput true "PIN"
+// @Success 200 {object} ParentalControlsResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /parentalcontrols/unlock [post]
+func UnlockParentalControls(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	if !checkParentalProfile(c, authUser.ProfileID) {
+		return
+	}
+
+	var userInput ParentalPinInput
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var user models.User
+	if err := initializers.DB.First(&user, authUser.ID).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if user.ParentalAgeCategoryID == nil {
+		NewErrorResponse(c, http.StatusBadRequest, "parental controls are not enabled")
+		return
+	}
+
+	if !checkParentalSecret(c, &user, userInput.Pin, "", "invalid pin") {
+		return
+	}
+
+	unlockedUntil, err := helpers.UnlockParentalControls(user.ID, authUser.SessionID)
+	if errors.Is(err, helpers.ErrKidsProfile) {
+		NewErrorResponse(c, http.StatusForbidden, helpers.ErrKidsProfile.Error())
+		return
+	}
+	if errors.Is(err, helpers.ErrSessionNotFound) {
+		NewErrorResponse(c, http.StatusNotFound, "session not found")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot unlock parental controls")
+		return
+	}
+
+	c.JSON(http.StatusOK, ParentalControlsResponse{
+		Enabled:       true,
+		AgeCategoryID: *user.ParentalAgeCategoryID,
+		UnlockedUntil: &unlockedUntil,
+	})
+}
+
+// LockParentalControls godoc
+// @Summary LockParentalControls
+// @Security ApiKeyAuth
+// @Tags parental-controls-controller
+// @ID lock-parental-controls
+// @Accept  json
+// @Produce  json
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /parentalcontrols/lock [post]
+func LockParentalControls(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	if err := helpers.LockParentalControls(authUser.ID, authUser.SessionID); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot lock parental controls")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "parental controls locked",
+	})
+}
+
+// checkParentalProfile responds with an error and returns false when the
+// request comes from a kids profile.
+func checkParentalProfile(c *gin.Context, profileID uint) bool {
+	isKids, err := helpers.IsKidsProfile(profileID)
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return false
+	}
+
+	if isKids {
+		NewErrorResponse(c, http.StatusForbidden, helpers.ErrKidsProfile.Error())
+		return false
+	}
+
+	return true
+}
+
+// checkParentalSecret accepts either the parental PIN or, for a forgotten
+// PIN, the account password. Failed attempts lock the parental controls of
+// the account like failed logins. It responds with the message and returns
+// false when the secret is wrong.
+func checkParentalSecret(c *gin.Context, user *models.User, pin, password, message string) bool {
+	key := helpers.ParentalPinKey(user.ID)
+
+	if retryAfter := helpers.LoginRetryAfter(key); retryAfter > 0 {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		NewErrorResponse(c, http.StatusTooManyRequests, "too many pin attempts, try again later")
+		return false
+	}
+
+	valid := pin != "" && helpers.CheckParentalPin(user, pin) ||
+		password != "" && user.Password != "" &&
+			bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)) == nil
+
+	if !valid {
+		if err := helpers.RegisterParentalPinFailure(key); err != nil {
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+			return false
+		}
+
+		NewErrorResponse(c, http.StatusForbidden, message)
+		return false
+	}
+
+	if err := helpers.ResetLoginFailures(key); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return false
+	}
+
+	return true
+}
+
+// ageRestriction limits a movie query to the age categories the request may
+// see under the parental controls of the account.
+func ageRestriction(c *gin.Context) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		maxAgeCategoryID, restricted := helpers.ParentalAgeCategoryID(c)
+		if !restricted {
+			return db
+		}
+
+		return db.Where("movies.age_category_id IN (?)", allowedAgeCategories(maxAgeCategoryID))
+	}
+}
+
+// isAgeAllowed reports whether a movie of the age category may be shown to
+// the request.
+func isAgeAllowed(c *gin.Context, ageCategoryID uint) (bool, error) {
+	maxAgeCategoryID, restricted := helpers.ParentalAgeCategoryID(c)
+	if !restricted {
+		return true, nil
+	}
+
+	var count int64
+	err := initializers.DB.Model(&models.AgeCategory{}).
+		Where("id = ? AND id IN (?)", ageCategoryID, allowedAgeCategories(maxAgeCategoryID)).
+		Count(&count).Error
+
+	return count > 0, err
+}
+
+// checkMovieAge responds with an error and returns false when the movie is
+// hidden by parental controls.
+func checkMovieAge(c *gin.Context, movie *models.Movie) bool {
+	allowed, err := isAgeAllowed(c, movie.AgeCategoryID)
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return false
+	}
+
+	if !allowed {
+		NewErrorResponse(c, http.StatusForbidden, "movie is restricted by parental controls")
+		return false
+	}
+
+	return true
+}
+
+// allowedAgeCategories selects the age categories rated up to the given one.
+// It selects none for an unknown or unrated category.
+func allowedAgeCategories(maxAgeCategoryID uint) *gorm.DB {
+	return initializers.DB.Model(&models.AgeCategory{}).
+		Select("id").
+		Where("minimum_age <= (?)", initializers.DB.Unscoped().Model(&models.AgeCategory{}).
+			Select("minimum_age").
+			Where("id = ?", maxAgeCategoryID))
+}
@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type PersonResponse struct {
+	ID    uint
+	Name  string
+	Photo string
+	Bio   string
+}
+
+type PersonList struct {
+	People []PersonResponse
+	Page   int
+	Limit  int
+	Total  int64
+}
+
+type FilmographyEntry struct {
+	MovieID       uint
+	NameOfProject string
+	Year          int
+	Cover         string
+	Role          string
+	Character     string
+}
+
+type PersonDetails struct {
+	PersonResponse
+	Filmography []FilmographyEntry
+}
+
+func newPersonResponse(person *models.Person) PersonResponse {
+	return PersonResponse{
+		ID:    person.ID,
+		Name:  person.Name,
+		Photo: person.Photo,
+		Bio:   person.Bio,
+	}
+}
+
+// GetPeople godoc
+// @Summary GetPeople
+// @Tags person-controller
+// @ID get-people
+// @Accept  json
+// @Produce  json
+// @Param page query integer false "page, starting from 1"
+// @Param limit query integer false "people per page"
+// @Param search query string false "part of the name"
+// @Success 200 {object} PersonList
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /people [get]
+func GetPeople(c *gin.Context) {
+	page, limit, ok := parsePagination(c)
+	if !ok {
+		return
+	}
+
+	query := initializers.DB.Model(&models.Person{})
+
+	if search := c.Query("search"); search != "" {
+		query = query.Where("LOWER(name) LIKE LOWER(?) ESCAPE '\\'", "%"+helpers.EscapeLike(search)+"%")
+	}
+
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get people")
+		return
+	}
+
+	var people []models.Person
+	if err := query.Order("name, id").Offset((page - 1) * limit).Limit(limit).Find(&people).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get people")
+		return
+	}
+
+	response := []PersonResponse{}
+	for i := range people {
+		response = append(response, newPersonResponse(&people[i]))
+	}
+
+	c.JSON(http.StatusOK, PersonList{
+		People: response,
+		Page:   page,
+		Limit:  limit,
+		Total:  total,
+	})
+}
+
+// GetPersonByID godoc
+// @Summary GetPersonByID
+// @Tags person-controller
+// @ID get-person-by-id
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "id"
+// @Success 200 {object} PersonDetails
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /person/{id} [get]
+func GetPersonByID(c *gin.Context) {
+	var person models.Person
+	if err := initializers.DB.First(&person, c.Param("id")).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "person not found")
+		return
+	}
+
+	filmography := []FilmographyEntry{}
+	err := initializers.DB.Model(&models.Credit{}).
+		Scopes(ageRestriction(c)).
+		Select("movies.id AS movie_id, movies.name_of_project, movies.year, movies.cover, credits.role, credits.character").
+		Joins("JOIN movies ON movies.id = credits.movie_id AND movies.deleted_at IS NULL").
+		Where("credits.person_id = ?", person.ID).
+		Order("movies.year DESC, movies.id, credits.position").
+		Scan(&filmography).Error
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get filmography")
+		return
+	}
+
+	c.JSON(http.StatusOK, PersonDetails{
+		PersonResponse: newPersonResponse(&person),
+		Filmography:    filmography,
+	})
+}
+
+// orderCredits lists the credits of a movie in their billing order.
+func orderCredits(db *gorm.DB) *gorm.DB {
+	return db.Order("position, id")
+}
@@ -0,0 +1,269 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type ProfileInput struct {
+	Name     string `json:"name" binding:"required,max=50" example:"Aru"`
+	Avatar   string `json:"avatar" binding:"omitempty,url" example:"https://example.com/avatar.png"`
+	IsKids   bool   `json:"isKids" example:"false"`
+	Language string `json:"language" binding:"omitempty,oneof=kk ru en" example:"kk"`
+}
+
+type ProfileResponse struct {
+	ID       uint
+	Name     string
+	Avatar   string
+	IsKids   bool
+	Language string
+}
+
+func newProfileResponse(profile *models.Profile) ProfileResponse {
+	return ProfileResponse{
+		ID:       profile.ID,
+		Name:     profile.Name,
+		Avatar:   profile.Avatar,
+		IsKids:   profile.IsKids,
+		Language: profile.Language,
+	}
+}
+
+// GetProfiles godoc
+// @Summary GetProfiles
+// @Security ApiKeyAuth
+// @Tags profile-controller
+// @ID get-profiles
+// @Accept  json
+// @Produce  json
+// @Success 200 {array} ProfileResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /profiles [get]
+func GetProfiles(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	profiles := []models.Profile{}
+	result := initializers.DB.Where("user_id = ?", authUser.ID).Order("id").Find(&profiles)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get profiles")
+		return
+	}
+
+	response := []ProfileResponse{}
+	for i := range profiles {
+		response = append(response, newProfileResponse(&profiles[i]))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateProfile godoc
+// @Summary CreateProfile
+// @Security ApiKeyAuth
+// @Tags profile-controller
+// @ID create-profile
+// @Accept  json
+// @Produce  json
+// @Param profile body ProfileInput true "profile"
+// @Success 200 {object} ProfileResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /profiles [post]
+func CreateProfile(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	var userInput ProfileInput
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var count int64
+	if err := initializers.DB.Model(&models.Profile{}).Where("user_id = ?", authUser.ID).Count(&count).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot create profile")
+		return
+	}
+
+	if count >= models.MaxProfilesPerUser {
+		NewErrorResponse(c, http.StatusBadRequest, "profile limit reached")
+		return
+	}
+
+	profile := models.Profile{
+		UserID:   authUser.ID,
+		Name:     userInput.Name,
+		Avatar:   userInput.Avatar,
+		IsKids:   userInput.IsKids,
+		Language: userInput.Language,
+	}
+
+	result := initializers.DB.Create(&profile)
+
+	if result.Error != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot create profile")
+		return
+	}
+
+	c.JSON(http.StatusOK, newProfileResponse(&profile))
+}
+
+// UpdateProfile godoc
+// @Summary UpdateProfile
+// @Security ApiKeyAuth
+// @Tags profile-controller
+// @ID update-profile
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "profileID"
+// @Param profile body ProfileInput true "profile"
+// @Success 200 {object} ProfileResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /profiles/{id} [put]
+func UpdateProfile(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+	id := c.Param("id")
+
+	var userInput ProfileInput
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var profile models.Profile
+	result := initializers.DB.Where("user_id = ?", authUser.ID).First(&profile, id)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "profile not found")
+		return
+	}
+
+	profile.Name = userInput.Name
+	profile.Avatar = userInput.Avatar
+	profile.IsKids = userInput.IsKids
+	profile.Language = userInput.Language
+
+	result = initializers.DB.Save(&profile)
+
+	if result.Error != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot update profile")
+		return
+	}
+
+	c.JSON(http.StatusOK, newProfileResponse(&profile))
+}
+
+// DeleteProfile godoc
+// @Summary DeleteProfile
+// @Security ApiKeyAuth
+// @Tags profile-controller
+// @ID delete-profile
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "profileID"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /profiles/{id} [delete]
+func DeleteProfile(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+	id := c.Param("id")
+
+	var profile models.Profile
+	result := initializers.DB.Where("user_id = ?", authUser.ID).First(&profile, id)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "profile not found")
+		return
+	}
+
+	var count int64
+	if err := initializers.DB.Model(&models.Profile{}).Where("user_id = ?", authUser.ID).Count(&count).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot delete profile")
+		return
+	}
+
+	if count <= 1 {
+		NewErrorResponse(c, http.StatusBadRequest, "the last profile cannot be deleted")
+		return
+	}
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("profile_id = ?", profile.ID).Delete(&models.Favorite{}).Error; err != nil {
+			return err
+		}
+
+		// sessions using the profile have to select another one
+		if err := tx.Model(&models.Session{}).Where("profile_id = ?", profile.ID).Update("profile_id", 0).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&profile).Error
+	})
+
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot delete profile")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "profile delete successfully",
+	})
+}
+
+// SelectProfile godoc
+// @Summary SelectProfile
+// @Security ApiKeyAuth
+// @Tags profile-controller
+// @ID select-profile
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "profileID"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /profiles/{id}/select [post]
+func SelectProfile(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	var profile models.Profile
+	result := initializers.DB.Where("user_id = ?", authUser.ID).First(&profile, c.Param("id"))
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "profile not found")
+		return
+	}
+
+	tokens, err := helpers.SelectProfile(authUser.ID, authUser.SessionID, authUser.TokenID, profile.ID)
+	if errors.Is(err, helpers.ErrProfileNotFound) {
+		NewErrorResponse(c, http.StatusNotFound, "profile not found")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to create token")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"Token":        tokens.AccessToken,
+		"RefreshToken": tokens.RefreshToken,
+		"Profile":      newProfileResponse(&profile),
+	})
+}
@@ -1,6 +1,12 @@
 package controllers
 
-import "github.com/gin-gonic/gin"
+import (
+	"net/http"
+
+	"github.com/diana-gemini/ozinshe/internal/passwordpolicy"
+
+	"github.com/gin-gonic/gin"
+)
 
 type ErrorResponse struct {
 	Message string `json:"message"`
@@ -9,3 +15,12 @@ type ErrorResponse struct {
 func NewErrorResponse(c *gin.Context, statusCode int, message string) {
 	c.AbortWithStatusJSON(statusCode, ErrorResponse{message})
 }
+
+type PasswordPolicyResponse struct {
+	Message    string                     `json:"message"`
+	Violations []passwordpolicy.Violation `json:"violations"`
+}
+
+func NewPasswordPolicyResponse(c *gin.Context, violations []passwordpolicy.Violation) {
+	c.AbortWithStatusJSON(http.StatusBadRequest, PasswordPolicyResponse{"password does not meet the policy", violations})
+}
@@ -4,10 +4,10 @@ import (
 	"net/http"
 
 	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
 	"github.com/diana-gemini/ozinshe/internal/models"
 
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
 // Search godoc
@@ -18,6 +18,8 @@ import (
 // @Accept json
 // @Produce json
 // @Param search query string true "search param received in the URL"
+// @Param lang query string false "kk, ru or en"
+// @Param Accept-Language header string false "used without lang"
 // @Success 200 {integer} integer 1
 // @Failure 400,404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -31,16 +33,34 @@ func Search(c *gin.Context) {
 	}
 
 	var movies []models.Movie
+	pattern := "%" + helpers.EscapeLike(search) + "%"
 
-	result := initializers.DB.Preload("Categories").
+	// a movie is found by its name in any locale or by one of its keywords
+	translationMatches := initializers.DB.Model(&models.MovieTranslation{}).
+		Select("movie_id").
+		Where("LOWER(name_of_project) LIKE LOWER(?) ESCAPE '\\'", pattern)
+
+	keywordMatches := initializers.DB.Model(&models.Keyword{}).
+		Select("movie_keywords.movie_id").
+		Joins("JOIN movie_keywords ON movie_keywords.keyword_id = keywords.id").
+		Where("keywords.name LIKE ? ESCAPE '\\'", "%"+helpers.EscapeLike(helpers.NormalizeKeyword(search))+"%")
+
+	result := initializers.DB.Scopes(ageRestriction(c)).Preload("Categories").
+		Preload("Keywords").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).Where("LOWER(name_of_project) LIKE LOWER(?) ", "%"+search+"%").Find(&movies)
+		Preload("Seasons", preloadVideos(c)).
+		Where("LOWER(name_of_project) LIKE LOWER(?) ESCAPE '\\' OR movies.id IN (?) OR movies.id IN (?)",
+			pattern, translationMatches, keywordMatches).
+		Find(&movies)
 	if err := result.Error; err != nil {
 		NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
 		return
 	}
+	if err := localizeMovies(c, movies); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize movies")
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"movies": movies,
 	})
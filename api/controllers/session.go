@@ -0,0 +1,121 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SessionResponse struct {
+	ID         uint
+	DeviceName string
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	Current    bool
+}
+
+// ListSessions godoc
+// @Summary ListSessions
+// @Security ApiKeyAuth
+// @Tags auth-controller
+// @ID list-sessions
+// @Accept  json
+// @Produce  json
+// @Success 200 {array} SessionResponse
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /sessions [get]
+func ListSessions(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	sessions, err := helpers.ListSessions(authUser.ID)
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot get sessions")
+		return
+	}
+
+	response := make([]SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		response = append(response, SessionResponse{
+			ID:         session.ID,
+			DeviceName: session.DeviceName,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+			Current:    session.ID == authUser.SessionID,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RevokeSession godoc
+// @Summary RevokeSession
+// @Security ApiKeyAuth
+// @Tags auth-controller
+// @ID revoke-session
+// @Accept  json
+// @Produce  json
+// @Param id path integer true "sessionID"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /sessions/{id} [delete]
+func RevokeSession(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	sessionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "session not found")
+		return
+	}
+
+	err = helpers.RevokeSession(authUser.ID, uint(sessionID))
+	if errors.Is(err, helpers.ErrSessionNotFound) {
+		NewErrorResponse(c, http.StatusNotFound, "session not found")
+		return
+	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to revoke session")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "session revoked successfully",
+	})
+}
+
+// RevokeOtherSessions godoc
+// @Summary RevokeOtherSessions
+// @Security ApiKeyAuth
+// @Tags auth-controller
+// @ID revoke-other-sessions
+// @Accept  json
+// @Produce  json
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /sessions [delete]
+func RevokeOtherSessions(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	if err := helpers.RevokeOtherSessions(authUser.ID, authUser.SessionID); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to revoke sessions")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "other sessions revoked successfully",
+	})
+}
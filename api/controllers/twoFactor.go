@@ -0,0 +1,306 @@
+package controllers
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/config"
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+	"github.com/diana-gemini/ozinshe/internal/totp"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+type TwoFactorCode struct {
+	Code string `json:"code" binding:"required" example:"123456"`
+}
+
+type DisableTwoFactorInput struct {
+	Password string `json:"password" binding:"required" example:"123456789"`
+	Code     string `json:"code" binding:"required" example:"123456"`
+}
+
+type TwoFactorLogin struct {
+	ChallengeToken string `json:"challengeToken" binding:"required"`
+	Code           string `json:"code" binding:"required" example:"123456"`
+}
+
+// EnrollTwoFactor godoc
+// @Summary EnrollTwoFactor
+// @Security ApiKeyAuth
+// @Tags two-factor-controller
+// @ID enroll-two-factor
+// @Accept  json
+// @Produce  json
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /2fa/enroll [post]
+func EnrollTwoFactor(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	var user models.User
+	result := initializers.DB.First(&user, authUser.ID)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if user.IsTwoFactorEnabled() {
+		NewErrorResponse(c, http.StatusBadRequest, "two-factor authentication is already enabled")
+		return
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to generate secret")
+		return
+	}
+
+	result = initializers.DB.Model(&user).Update("two_factor_secret", secret)
+
+	if result.Error != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "two-factor authentication is not enrolled")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"secret":          secret,
+		"provisioningURI": totp.ProvisioningURI(config.TwoFactorIssuer(), user.Email, secret),
+	})
+}
+
+// EnableTwoFactor godoc
+// @Summary EnableTwoFactor
+// @Security ApiKeyAuth
+// @Tags two-factor-controller
+// @ID enable-two-factor
+// @Accept  json
+// @Produce  json
+// @Param code body TwoFactorCode true "code"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /2fa/enable [post]
+func EnableTwoFactor(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	var userInput TwoFactorCode
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var user models.User
+	result := initializers.DB.First(&user, authUser.ID)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if user.IsTwoFactorEnabled() {
+		NewErrorResponse(c, http.StatusBadRequest, "two-factor authentication is already enabled")
+		return
+	}
+
+	if user.TwoFactorSecret == "" {
+		NewErrorResponse(c, http.StatusBadRequest, "two-factor authentication is not enrolled")
+		return
+	}
+
+	step, ok := totp.Validate(user.TwoFactorSecret, userInput.Code, time.Now())
+	if !ok {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid code")
+		return
+	}
+
+	var recoveryCodes []string
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&user).Updates(map[string]interface{}{
+			"two_factor_enabled_at": time.Now(),
+			"two_factor_last_step":  step,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		recoveryCodes, err = helpers.ReplaceRecoveryCodes(tx, user.ID)
+		return err
+	})
+
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "two-factor authentication is not enabled")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"recoveryCodes": recoveryCodes,
+	})
+}
+
+// DisableTwoFactor godoc
+// @Summary DisableTwoFactor
+// @Security ApiKeyAuth
+// @Tags two-factor-controller
+// @ID disable-two-factor
+// @Accept  json
+// @Produce  json
+// @Param disableTwoFactor body DisableTwoFactorInput true "disableTwoFactor"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /2fa/disable [post]
+func DisableTwoFactor(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	var userInput DisableTwoFactorInput
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	var user models.User
+	result := initializers.DB.First(&user, authUser.ID)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	if !user.IsTwoFactorEnabled() {
+		NewErrorResponse(c, http.StatusBadRequest, "two-factor authentication is not enabled")
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(userInput.Password)); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid password")
+		return
+	}
+
+	ok, err := helpers.VerifyTwoFactorCode(&user, userInput.Code)
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+	if !ok {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid code")
+		return
+	}
+
+	err = initializers.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&user).Updates(map[string]interface{}{
+			"two_factor_secret":     "",
+			"two_factor_enabled_at": nil,
+			"two_factor_last_step":  0,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Where("user_id = ?", user.ID).Delete(&models.RecoveryCode{}).Error
+	})
+
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "two-factor authentication is not disabled")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "two-factor authentication disabled",
+	})
+}
+
+// LoginTwoFactor godoc
+// @Summary LoginTwoFactor
+// @Tags auth-controller
+// @ID log-in-two-factor
+// @Accept  json
+// @Produce  json
+// @Param twoFactorLogin body TwoFactorLogin true "twoFactorLogin"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /login/2fa [post]
+func LoginTwoFactor(c *gin.Context) {
+	var userInput TwoFactorLogin
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	userID, err := helpers.ParseTwoFactorChallenge(userInput.ChallengeToken)
+	if err != nil {
+		NewErrorResponse(c, http.StatusUnauthorized, "invalid challenge token")
+		return
+	}
+
+	var user models.User
+	result := initializers.DB.First(&user, userID)
+
+	if err := result.Error; err != nil || !user.IsTwoFactorEnabled() {
+		NewErrorResponse(c, http.StatusUnauthorized, "invalid challenge token")
+		return
+	}
+
+	if user.IsSuspended() {
+		NewErrorResponse(c, http.StatusForbidden, "account is suspended")
+		return
+	}
+
+	accountKey := helpers.LoginAccountKey(user.Email)
+	ipKey := helpers.LoginIPKey(c.ClientIP())
+
+	if retryAfter := helpers.LoginRetryAfter(accountKey, ipKey); retryAfter > 0 {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		NewErrorResponse(c, http.StatusTooManyRequests, "too many login attempts, try again later")
+		return
+	}
+
+	ok, err := helpers.VerifyTwoFactorCode(&user, userInput.Code)
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	if !ok {
+		if err := helpers.RegisterLoginFailure(accountKey, ipKey); err != nil {
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+			return
+		}
+
+		NewErrorResponse(c, http.StatusBadRequest, "invalid code")
+		return
+	}
+
+	if err := helpers.ResetLoginFailures(accountKey); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	tokens, err := helpers.IssueTokens(user.ID, helpers.NewSessionInfo(c))
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to create token")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"Token":        tokens.AccessToken,
+		"RefreshToken": tokens.RefreshToken,
+	})
+}
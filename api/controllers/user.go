@@ -1,22 +1,23 @@
 package controllers
 
 import (
-	"crypto/rand"
-	"encoding/hex"
-	"fmt"
+	"errors"
+	"log"
+	"math"
 	"net/http"
-	"os"
+	"net/url"
+	"strconv"
 	"time"
 
+	"github.com/diana-gemini/ozinshe/config"
 	"github.com/diana-gemini/ozinshe/db/initializers"
 	"github.com/diana-gemini/ozinshe/internal/helpers"
 	"github.com/diana-gemini/ozinshe/internal/models"
 	"github.com/diana-gemini/ozinshe/internal/validations"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
-	"gopkg.in/gomail.v2"
+	"gorm.io/gorm"
 )
 
 type SignUpUser struct {
@@ -50,8 +51,13 @@ func Signup(c *gin.Context) {
 		return
 	}
 
-	if !validations.CheckPassword(userInput.Password, userInput.RepeatPassword) {
-		NewErrorResponse(c, http.StatusBadRequest, "passwords should be the same")
+	violations, err := helpers.CheckPasswordPolicy(userInput.Password, userInput.RepeatPassword, userInput.Email, nil)
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to check password")
+		return
+	}
+	if len(violations) > 0 {
+		NewPasswordPolicyResponse(c, violations)
 		return
 	}
 
@@ -62,23 +68,53 @@ func Signup(c *gin.Context) {
 		return
 	}
 
+	var role models.Role
+	if err := initializers.DB.First(&role, "name = ?", models.RoleUser).Error; err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "default role not found")
+		return
+	}
+
 	user := models.User{
 		Email:    userInput.Email,
 		Password: string(hashPassword),
-		RoleID:   2,
+		RoleID:   role.ID,
 	}
 
-	result := initializers.DB.Create(&user)
+	if config.EmailVerificationMode() == config.EmailVerificationOff {
+		now := time.Now()
+		user.EmailVerifiedAt = &now
+	}
 
-	if result.Error != nil {
+	err = initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&user).Error; err != nil {
+			return err
+		}
+
+		if err := helpers.CreateDefaultProfile(tx, &user); err != nil {
+			return err
+		}
+
+		return helpers.RecordPassword(tx, user.ID, user.Password)
+	})
+
+	if err != nil {
 		NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
 		return
 	}
+
+	if !user.IsEmailVerified() {
+		if err := sendVerificationEmail(&user); err != nil {
+			log.Println("failed to send verification email:", err)
+		}
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"User": user,
 	})
 }
 
+var dummyPasswordHash = "$2a$10$/z3c4AK/.p/u8SHyAiPYROQGL/mH6uQTHu7.uqyCuc0EdfQRE07qC"
+
 type AuthUser struct {
 	Email    string `json:"email" binding:"required,email" example:"user@mail.ru"`
 	Password string `json:"password" binding:"required" example:"123456789"`
@@ -104,32 +140,119 @@ func Login(c *gin.Context) {
 		return
 	}
 
+	accountKey := helpers.LoginAccountKey(userInput.Email)
+	ipKey := helpers.LoginIPKey(c.ClientIP())
+
+	if retryAfter := helpers.LoginRetryAfter(accountKey, ipKey); retryAfter > 0 {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		NewErrorResponse(c, http.StatusTooManyRequests, "too many login attempts, try again later")
+		return
+	}
+
 	var user models.User
 	initializers.DB.First(&user, "email = ?", userInput.Email)
+
+	passwordHash := user.Password
 	if user.ID == 0 {
-		NewErrorResponse(c, http.StatusBadRequest, "invalid email")
+		// compare against a dummy hash so that unknown emails take as long as wrong passwords
+		passwordHash = dummyPasswordHash
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(userInput.Password))
+	if user.ID == 0 || err != nil {
+		if err := helpers.RegisterLoginFailure(accountKey, ipKey); err != nil {
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+			return
+		}
+
+		NewErrorResponse(c, http.StatusBadRequest, "invalid email or password")
+		return
+	}
+
+	if err := helpers.ResetLoginFailures(accountKey); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	if !user.IsEmailVerified() && config.EmailVerificationMode() == config.EmailVerificationRequired {
+		NewErrorResponse(c, http.StatusForbidden, "email is not verified")
 		return
 	}
 
-	err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(userInput.Password))
+	respondWithLogin(c, &user)
+}
+
+// respondWithLogin returns the token pair, or the two-factor challenge for
+// users who have to confirm the login with a code.
+func respondWithLogin(c *gin.Context, user *models.User) {
+	if user.IsSuspended() {
+		NewErrorResponse(c, http.StatusForbidden, "account is suspended")
+		return
+	}
+
+	if user.IsTwoFactorEnabled() {
+		challengeToken, err := helpers.GenerateTwoFactorChallenge(user.ID)
+		if err != nil {
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to create token")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"TwoFactorRequired": true,
+			"ChallengeToken":    challengeToken,
+		})
+		return
+	}
+
+	tokens, err := helpers.IssueTokens(user.ID, helpers.NewSessionInfo(c))
 	if err != nil {
-		NewErrorResponse(c, http.StatusBadRequest, "invalid password")
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to create token")
 		return
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub": user.ID,
-		"exp": time.Now().Add(time.Hour * 24 * 30).Unix(),
+	c.JSON(http.StatusOK, gin.H{
+		"Token":        tokens.AccessToken,
+		"RefreshToken": tokens.RefreshToken,
 	})
+}
+
+type RefreshTokenInput struct {
+	RefreshToken string `json:"refreshToken" binding:"required" example:"3f9c1c0e..."`
+}
 
-	tokenString, err := token.SignedString([]byte(os.Getenv("SECRET")))
+// RefreshToken godoc
+// @Summary RefreshToken
+// @Tags auth-controller
+// @ID refresh-token
+// @Accept  json
+// @Produce  json
+// @Param refreshToken body RefreshTokenInput true "refreshToken"
+// @Success 200 {integer} integer 1
+// @Failure 400,404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure default {object} ErrorResponse
+// @Router /token/refresh [post]
+func RefreshToken(c *gin.Context) {
+	var userInput RefreshTokenInput
+
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	tokens, err := helpers.RotateRefreshToken(userInput.RefreshToken, helpers.NewSessionInfo(c))
+	if errors.Is(err, helpers.ErrInvalidRefreshToken) {
+		NewErrorResponse(c, http.StatusUnauthorized, "invalid refresh token")
+		return
+	}
 	if err != nil {
-		NewErrorResponse(c, http.StatusBadRequest, "failed to create token")
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to refresh token")
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"Token": tokenString,
+		"Token":        tokens.AccessToken,
+		"RefreshToken": tokens.RefreshToken,
 	})
 }
 
@@ -146,6 +269,13 @@ func Login(c *gin.Context) {
 // @Failure default {object} ErrorResponse
 // @Router /logout [post]
 func Logout(c *gin.Context) {
+	authUser := helpers.GetAuthUser(c)
+
+	if err := helpers.RevokeSession(authUser.ID, authUser.SessionID); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to revoke token")
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"Logout": "Logout successful",
 	})
@@ -268,44 +398,54 @@ func ChangePassword(c *gin.Context) {
 		return
 	}
 
-	if !validations.CheckPassword(userInput.Password, userInput.RepeatPassword) {
-		NewErrorResponse(c, http.StatusBadRequest, "passwords should be the same")
+	var user models.User
+	result := initializers.DB.First(&user, id)
+
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
 		return
 	}
 
-	hashPassword, err := bcrypt.GenerateFromPassword([]byte(userInput.Password), 10)
-
+	violations, err := helpers.CheckPasswordPolicy(userInput.Password, userInput.RepeatPassword, user.Email, &user)
 	if err != nil {
-		NewErrorResponse(c, http.StatusInternalServerError, "failed to hash password")
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to check password")
+		return
+	}
+	if len(violations) > 0 {
+		NewPasswordPolicyResponse(c, violations)
 		return
 	}
 
-	var user models.User
-	result := initializers.DB.First(&user, id)
+	hashPassword, err := bcrypt.GenerateFromPassword([]byte(userInput.Password), 10)
 
-	if err := result.Error; err != nil {
-		NewErrorResponse(c, http.StatusNotFound, "user not found")
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to hash password")
 		return
 	}
 
-	updateUserPassword := models.User{
-		Password: string(hashPassword),
-	}
+	err = initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&user).Update("password", string(hashPassword)).Error; err != nil {
+			return err
+		}
 
-	result = initializers.DB.Model(&user).Updates(&updateUserPassword)
+		return helpers.RecordPassword(tx, user.ID, string(hashPassword))
+	})
 
-	if result.Error != nil {
+	if err != nil {
 		NewErrorResponse(c, http.StatusInternalServerError, "user password is not changed")
 		return
 	}
 
+	if err := helpers.RevokeUserTokens(user.ID); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to revoke tokens")
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"password": "Password successfully changed",
 	})
 }
 
-var resetTokens = make(map[string]string)
-
 type RecoverUserPassword struct {
 	Email string `json:"email" binding:"required,email" example:"user@mail.ru"`
 }
@@ -338,10 +478,13 @@ func PasswordRecover(c *gin.Context) {
 		return
 	}
 
-	token := generateToken()
-	resetTokens[token] = userInput.Email
+	token, err := helpers.IssuePasswordResetToken(user.ID)
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to create reset token")
+		return
+	}
 
-	err := sendResetEmail(userInput.Email, token)
+	err = sendResetEmail(userInput.Email, token)
 	if err != nil {
 		NewErrorResponse(c, http.StatusInternalServerError, "failed to send reset email")
 		return
@@ -352,25 +495,12 @@ func PasswordRecover(c *gin.Context) {
 	})
 }
 
-func generateToken() string {
-	b := make([]byte, 16)
-	rand.Read(b)
-	return hex.EncodeToString(b)
-}
-
 func sendResetEmail(email, token string) error {
-	m := gomail.NewMessage()
-	m.SetHeader("From", "diana-test-project@mail.ru")
-	m.SetHeader("To", email)
-	m.SetHeader("Subject", "Password Reset")
-	m.SetBody("text/html", fmt.Sprintf("Click the following link to reset your password: <a href=\"http://localhost:3000/resetpassword?token=%s\">Reset Password</a>", token))
+	link := config.PublicURL("/resetpassword", url.Values{"token": {token}})
 
-	d := gomail.NewDialer("smtp.mail.ru", 587, "diana-test-project@mail.ru", "phwEkPnEPudpnmU0Pvvn")
-
-	if err := d.DialAndSend(m); err != nil {
-		return err
-	}
-	return nil
+	return helpers.SendEmail(email, "Password Reset", "password_reset", gin.H{
+		"Link": link,
+	})
 }
 
 // ResetPassword godoc
@@ -399,41 +529,67 @@ func ResetPassword(c *gin.Context) {
 		return
 	}
 
-	email, ok := resetTokens[token]
-	if !ok {
+	userID, err := helpers.FindPasswordResetToken(token)
+	if errors.Is(err, helpers.ErrInvalidResetToken) {
 		NewErrorResponse(c, http.StatusBadRequest, "invalid reset token")
 		return
 	}
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "password not update")
+		return
+	}
 
-	if !validations.CheckPassword(password.Password, password.RepeatPassword) {
-		NewErrorResponse(c, http.StatusBadRequest, "passwords should be the same")
+	var user models.User
+	if err := initializers.DB.First(&user, userID).Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	// the slow password checks run before the transaction; the token is
+	// only used up once the password is accepted
+	violations, err := helpers.CheckPasswordPolicy(password.Password, password.RepeatPassword, user.Email, &user)
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "password not update")
+		return
+	}
+	if len(violations) > 0 {
+		NewPasswordPolicyResponse(c, violations)
 		return
 	}
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password.Password), bcrypt.DefaultCost)
 	if err != nil {
-		NewErrorResponse(c, http.StatusInternalServerError, "failed to generate password hash")
+		NewErrorResponse(c, http.StatusInternalServerError, "password not update")
 		return
 	}
 
-	delete(resetTokens, token)
+	err = initializers.DB.Transaction(func(tx *gorm.DB) error {
+		consumedUserID, err := helpers.ConsumePasswordResetToken(tx, token)
+		if err != nil {
+			return err
+		}
+		if consumedUserID != user.ID {
+			return helpers.ErrInvalidResetToken
+		}
 
-	var user models.User
-	result := initializers.DB.Where("email = ?", email).First(&user)
+		if err := tx.Model(&user).Update("password", string(hashedPassword)).Error; err != nil {
+			return err
+		}
 
-	if err := result.Error; err != nil {
-		NewErrorResponse(c, http.StatusNotFound, "email not found")
+		return helpers.RecordPassword(tx, user.ID, string(hashedPassword))
+	})
+
+	if errors.Is(err, helpers.ErrInvalidResetToken) {
+		NewErrorResponse(c, http.StatusBadRequest, "invalid reset token")
 		return
 	}
-
-	updateUserPassword := models.User{
-		Password: string(hashedPassword),
+	if err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "password not update")
+		return
 	}
 
-	result = initializers.DB.Model(&user).Updates(&updateUserPassword)
-
-	if result.Error != nil {
-		NewErrorResponse(c, http.StatusInternalServerError, "password not update")
+	if err := helpers.RevokeUserTokens(user.ID); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "failed to revoke tokens")
 		return
 	}
 
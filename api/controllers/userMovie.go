@@ -10,7 +10,6 @@ import (
 	"github.com/diana-gemini/ozinshe/internal/validations"
 
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
 // GetAllMovies godoc
@@ -20,24 +19,41 @@ import (
 // @ID get-all-movies
 // @Accept  json
 // @Produce  json
+// @Param yearfrom query integer false "released in or after"
+// @Param yearto query integer false "released in or before"
+// @Param runtimemin query integer false "at least this many minutes long"
+// @Param runtimemax query integer false "at most this many minutes long"
+// @Param keyword query string false "keyword"
+// @Param sort query string false "year, -year, runtime or -runtime"
+// @Param lang query string false "kk, ru or en"
+// @Param Accept-Language header string false "used without lang"
 // @Success 200 {integer} integer 1
 // @Failure 400,404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Failure default {object} ErrorResponse
 // @Router /all [get]
 func GetAllMovies(c *gin.Context) {
+	query, ok := filterMovies(c, initializers.DB.Scopes(ageRestriction(c)))
+	if !ok {
+		return
+	}
+
 	var movies []models.Movie
-	result := initializers.DB.Preload("Categories").
+	result := query.Preload("Categories").
+		Preload("Keywords").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).Find(&movies)
+		Preload("Seasons", preloadVideos(c)).Find(&movies)
 
 	if err := result.Error; err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "movies not found")
 		return
 	}
 
+	if err := localizeMovies(c, movies); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize movies")
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"Movies": movies,
 	})
@@ -51,28 +67,26 @@ func GetAllMovies(c *gin.Context) {
 // @Accept  json
 // @Produce  json
 // @Param id path integer true "id"
+// @Param lang query string false "kk, ru or en"
+// @Param Accept-Language header string false "used without lang"
 // @Success 200 {integer} integer 1
 // @Failure 400,404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Failure default {object} ErrorResponse
 // @Router /movie/{id} [get]
 func GetMovieByID(c *gin.Context) {
-	authUser := helpers.GetAuthUser(c)
-	userID := authUser.ID
-
 	movieID, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		NewErrorResponse(c, http.StatusBadRequest, "cannot convert to int")
 	}
 
-	isUserFavorite := validations.IsUniqueTwoValue("favorites", "user_id", "movie_id", userID, uint(movieID))
-
 	var movie models.Movie
 	result := initializers.DB.Preload("Categories").
+		Preload("Keywords").
+		Preload("Credits", orderCredits).
+		Preload("Credits.Person").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).
+		Preload("Seasons", preloadVideos(c)).
 		First(&movie, movieID)
 
 	if err := result.Error; err != nil {
@@ -80,12 +94,14 @@ func GetMovieByID(c *gin.Context) {
 		return
 	}
 
+	if !checkMovieAge(c, &movie) {
+		return
+	}
+
 	var similarSerial []models.Movie
-	similarSerialResult := initializers.DB.Preload("Categories").
+	similarSerialResult := initializers.DB.Scopes(ageRestriction(c)).Preload("Categories").
 		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).Limit(limitOfMovie).
+		Preload("Seasons", preloadVideos(c)).Limit(limitOfMovie).
 		Find(&similarSerial)
 
 	if err := similarSerialResult.Error; err != nil {
@@ -99,11 +115,28 @@ func GetMovieByID(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"movie":          movie,
-		"isUserFavorite": isUserFavorite,
-		"similarSerial":  similarSerial,
-	})
+	if err := localizeMovie(c, &movie); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize movies")
+		return
+	}
+
+	if err := localizeMovies(c, similarSerial); err != nil {
+		NewErrorResponse(c, http.StatusInternalServerError, "cannot localize movies")
+		return
+	}
+
+	response := gin.H{
+		"movie":         movie,
+		"similarSerial": similarSerial,
+	}
+
+	// guests get no user-specific fields
+	if authUser := helpers.GetOptionalAuthUser(c); authUser != nil {
+		response["isUserFavorite"] = authUser.ProfileID != 0 &&
+			validations.IsUniqueTwoValue("favorites", "profile_id", "movie_id", authUser.ProfileID, uint(movieID))
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // GetMovieSeriesByID godoc
@@ -114,48 +147,52 @@ func GetMovieByID(c *gin.Context) {
 // @Accept  json
 // @Produce  json
 // @Param id path integer true "movieid"
-// @Param seasonid path integer true "seasonid"
-// @Param seriesid path integer true "seriesid"
-// @Success 200 {integer} integer 1
+// @Param seasonnumber path integer true "season number"
+// @Param episodenumber path integer true "episode number"
+// @Success 200 {object} EpisodeResponse
 // @Failure 400,404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Failure default {object} ErrorResponse
-// @Router /movie/{id}/series/{seasonid}/{seriesid} [get]
+// @Router /movie/{id}/series/{seasonnumber}/{episodenumber} [get]
 func GetMovieSeriesByID(c *gin.Context) {
 	movieID := c.Param("id")
 
-	seasonID, err := strconv.Atoi(c.Params.ByName("seasonid"))
+	seasonNumber, err := strconv.Atoi(c.Params.ByName("seasonnumber"))
 	if err != nil {
-		NewErrorResponse(c, http.StatusNotFound, "season id not found")
+		NewErrorResponse(c, http.StatusNotFound, "season not found")
 		return
 	}
 
-	seriesID, err := strconv.Atoi(c.Params.ByName("seriesid"))
+	episodeNumber, err := strconv.Atoi(c.Params.ByName("episodenumber"))
 	if err != nil {
-		NewErrorResponse(c, http.StatusNotFound, "series id not found")
+		NewErrorResponse(c, http.StatusNotFound, "episode not found")
 		return
 	}
 
 	var movie models.Movie
-	result := initializers.DB.Preload("Categories").
-		Preload("Screenshots").
-		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
-			return db.Preload("Videos")
-		}).First(&movie, movieID)
-
-	if err := result.Error; err != nil {
+	if err := initializers.DB.First(&movie, movieID).Error; err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "movie not found")
 		return
 	}
 
-	if len(movie.Seasons) <= seasonID-1 || len(movie.Seasons[seasonID-1].Videos) <= seriesID-1 {
-		NewErrorResponse(c, http.StatusNotFound, "series not found")
+	if !checkMovieAge(c, &movie) {
 		return
 	}
 
-	seriesLink := movie.Seasons[seasonID-1].Videos[seriesID-1].Link
+	var season models.Season
+	result := initializers.DB.Where("movie_id = ? AND number = ?", movie.ID, seasonNumber).First(&season)
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "season not found")
+		return
+	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"Series": seriesLink,
-	})
+	var video models.Video
+	result = initializers.DB.Where("season_id = ? AND episode_number = ?", season.ID, episodeNumber).
+		First(&video)
+	if err := result.Error; err != nil {
+		NewErrorResponse(c, http.StatusNotFound, "episode not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, newEpisodeResponse(&video))
 }
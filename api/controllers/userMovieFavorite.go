@@ -13,8 +13,9 @@ import (
 )
 
 type FavoriteResponse struct {
-	UserID  uint
-	MovieID uint
+	UserID    uint
+	ProfileID uint
+	MovieID   uint
 }
 
 // AddMovieToFavorite godoc
@@ -44,14 +45,15 @@ func AddMovieToFavorite(c *gin.Context) {
 		return
 	}
 
-	if validations.IsUniqueTwoValue("favorites", "user_id", "movie_id", authUser.ID, uint(movieID)) {
+	if validations.IsUniqueTwoValue("favorites", "profile_id", "movie_id", authUser.ProfileID, uint(movieID)) {
 		NewErrorResponse(c, http.StatusBadRequest, "this favorite movie is already exist")
 		return
 	}
 
 	favoriteMovie := models.Favorite{
-		UserID:  authUser.ID,
-		MovieID: uint(movieID),
+		UserID:    authUser.ID,
+		ProfileID: authUser.ProfileID,
+		MovieID:   uint(movieID),
 	}
 
 	result := initializers.DB.Create(&favoriteMovie)
@@ -62,6 +64,7 @@ func AddMovieToFavorite(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{
 		"UserID":        favoriteMovie.UserID,
+		"ProfileID":     favoriteMovie.ProfileID,
 		"FavoriteMovie": favoriteMovie.MovieID,
 	})
 }
@@ -85,7 +88,7 @@ func DeleteMovieFromFavorite(c *gin.Context) {
 
 	var favorite models.Favorite
 
-	result := initializers.DB.Where("movie_id = ? AND user_id = ?", movieID, authUser.ID).First(&favorite)
+	result := initializers.DB.Where("movie_id = ? AND profile_id = ?", movieID, authUser.ProfileID).First(&favorite)
 	if err := result.Error; err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "user favorite movie is not found")
 		return
@@ -113,7 +116,7 @@ func DeleteMovieFromFavorite(c *gin.Context) {
 func GetAllFavoriteMovies(c *gin.Context) {
 	user := helpers.GetAuthUser(c)
 	var favoriteMovies []models.Favorite
-	result := initializers.DB.Where("user_id = ?", user.ID).Find(&favoriteMovies)
+	result := initializers.DB.Where("profile_id = ?", user.ProfileID).Find(&favoriteMovies)
 
 	if err := result.Error; err != nil {
 		NewErrorResponse(c, http.StatusNotFound, "favorite movie is not found")
@@ -124,8 +127,9 @@ func GetAllFavoriteMovies(c *gin.Context) {
 
 	for _, favorite := range favoriteMovies {
 		response = append(response, FavoriteResponse{
-			UserID:  favorite.UserID,
-			MovieID: favorite.MovieID,
+			UserID:    favorite.UserID,
+			ProfileID: favorite.ProfileID,
+			MovieID:   favorite.MovieID,
 		})
 	}
 
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePermission lets the request through only when the role of the
+// authenticated user grants every listed permission.
+func RequirePermission(permissions ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authUser, exists := c.Get("authUser")
+		if !exists {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, "failed to get the user")
+			return
+		}
+
+		user, ok := authUser.(AuthUser)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		for _, permission := range permissions {
+			if !user.HasPermission(permission) {
+				c.AbortWithStatusJSON(http.StatusForbidden, "forbidden")
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+func (u AuthUser) HasPermission(permission string) bool {
+	for _, granted := range u.Permissions {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func rolePermissions(roleID uint) []string {
+	var permissions []string
+
+	initializers.DB.Model(&models.Permission{}).
+		Joins("JOIN role_permissions ON role_permissions.permission_id = permissions.id").
+		Where("role_permissions.role_id = ?", roleID).
+		Pluck("permissions.name", &permissions)
+
+	return permissions
+}
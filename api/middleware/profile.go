@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireProfile blocks requests whose token does not carry a selected
+// viewer profile.
+func RequireProfile() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authUser, exists := c.Get("authUser")
+		if !exists {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, "failed to get the user")
+			return
+		}
+
+		user, ok := authUser.(AuthUser)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		if user.ProfileID == 0 {
+			c.AbortWithStatusJSON(http.StatusForbidden, "profile is not selected")
+			return
+		}
+
+		c.Next()
+	}
+}
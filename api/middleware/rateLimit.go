@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/internal/ratelimit"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimit limits requests of a route group per user, or per client IP for
+// anonymous requests, and reports the state in RateLimit-* headers.
+func RateLimit(store ratelimit.Store, group string, limit ratelimit.Limit) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := group + ":ip:" + c.ClientIP()
+		if authUser, exists := c.Get("authUser"); exists {
+			if user, ok := authUser.(AuthUser); ok {
+				key = fmt.Sprintf("%s:user:%d", group, user.ID)
+				if user.APIKeyID != 0 {
+					key = fmt.Sprintf("%s:apikey:%d", group, user.APIKeyID)
+				}
+			}
+		}
+
+		result, err := store.Take(key, limit)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		c.Header("RateLimit-Limit", strconv.Itoa(limit.Burst))
+		c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("RateLimit-Reset", seconds(result.ResetAfter))
+
+		if !result.Allowed {
+			c.Header("Retry-After", seconds(result.RetryAfter))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, "too many requests")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -1,9 +1,9 @@
 package middleware
 
 import (
-	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,82 +15,219 @@ import (
 )
 
 type AuthUser struct {
-	ID    uint   `json:"ID"`
-	Email string `json:"Email"`
-	Role  uint   `json:"Role"`
+	ID               uint     `json:"ID"`
+	Email            string   `json:"Email"`
+	Role             uint     `json:"Role"`
+	EmailVerified    bool     `json:"EmailVerified"`
+	TwoFactorEnabled bool     `json:"TwoFactorEnabled"`
+	Permissions      []string `json:"Permissions"`
+	TokenID          string   `json:"-"`
+	SessionID        uint     `json:"-"`
+	ProfileID        uint     `json:"ProfileID"`
+	// APIKeyID is set when the request authenticated with an API key
+	// instead of a user token.
+	APIKeyID uint `json:"-"`
+	// ParentalRestricted is set when the catalog is restricted for the
+	// request. ParentalAgeCategoryID is then the highest age category it may
+	// see; with 0 no movie is shown.
+	ParentalRestricted    bool `json:"-"`
+	ParentalAgeCategoryID uint `json:"-"`
 }
 
+// defaultKidsMaxAge is the age kids profiles are limited to unless the
+// parental controls of the account are stricter.
+const defaultKidsMaxAge = 6
+
+// sessionTouchInterval limits how often the last-seen time of a session is
+// written to the database.
+const sessionTouchInterval = time.Minute
+
 func RequireAuth(c *gin.Context) {
+	authUser, status := authenticate(c)
+	if authUser == nil {
+		abortAuthentication(c, status)
+		return
+	}
+
+	c.Set("authUser", *authUser)
+	c.Next()
+}
+
+// OptionalAuth lets requests without an Authorization header through as
+// guests. A token that is sent has to be valid: falling back to a guest would
+// skip the parental controls and the suspension of the account.
+func OptionalAuth(c *gin.Context) {
+	if c.GetHeader("Authorization") == "" {
+		c.Next()
+		return
+	}
+
+	authUser, status := authenticate(c)
+	if authUser == nil {
+		abortAuthentication(c, status)
+		return
+	}
+
+	c.Set("authUser", *authUser)
+	c.Next()
+}
+
+func abortAuthentication(c *gin.Context, status int) {
+	if status == http.StatusForbidden {
+		c.AbortWithStatusJSON(http.StatusForbidden, "account is suspended")
+		return
+	}
+	c.AbortWithStatusJSON(http.StatusUnauthorized, "unauthorized")
+}
+
+// authenticate resolves the bearer token of the request. On failure it
+// returns the status to reject the request with.
+func authenticate(c *gin.Context) (*AuthUser, int) {
 	var tokenString string
 	tokenArray := strings.Split(c.GetHeader("Authorization"), " ")
 
 	if len(tokenArray) >= 2 {
 		tokenString = tokenArray[1]
-		if tokenString == "" {
-			c.AbortWithStatus(http.StatusUnauthorized)
-		}
-	} else {
-		c.AbortWithStatus(http.StatusUnauthorized)
+	}
+	if tokenString == "" {
+		return nil, http.StatusUnauthorized
 	}
 
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-
-		return []byte(os.Getenv("SECRET")), nil
-	})
+	token, err := jwt.Parse(tokenString, initializers.SigningKeys.Keyfunc)
 
 	if err != nil || !token.Valid {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, "unauthorized")
-		return
+		return nil, http.StatusUnauthorized
 	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		if float64(time.Now().Unix()) > claims["exp"].(float64) {
-			c.AbortWithStatus(http.StatusUnauthorized)
-		}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
 
-		var user models.User
-		initializers.DB.Find(&user, claims["sub"])
+	exp, ok := claims["exp"].(float64)
+	if !ok || float64(time.Now().Unix()) > exp {
+		return nil, http.StatusUnauthorized
+	}
 
-		if user.ID == 0 {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, "unauthorized")
-			return
-		}
+	tokenID, ok := claims["jti"].(string)
+	if !ok || tokenID == "" {
+		return nil, http.StatusUnauthorized
+	}
 
-		authUser := AuthUser{
-			ID:    user.ID,
-			Email: user.Email,
-			Role:  user.RoleID,
-		}
+	// a token that cannot be checked is treated as revoked
+	var revoked int64
+	err = initializers.DB.Unscoped().Model(&models.RevokedToken{}).Where("jti = ?", tokenID).Count(&revoked).Error
+	if err != nil || revoked > 0 {
+		return nil, http.StatusUnauthorized
+	}
 
-		c.Set("authUser", authUser)
-		c.Next()
-	} else {
-		c.AbortWithStatus(http.StatusUnauthorized)
+	var user models.User
+	initializers.DB.Find(&user, claims["sub"])
+
+	if user.ID == 0 {
+		return nil, http.StatusUnauthorized
 	}
-}
 
-func IsAdmin() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		authUser, exists := c.Get("authUser")
-		if !exists {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, "failed to get the user")
-			return
-		}
+	if user.IsSuspended() {
+		return nil, http.StatusForbidden
+	}
 
-		user, ok := authUser.(AuthUser)
-		if !ok {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, "unauthorized")
-			return
-		}
+	sessionID, ok := claims["sid"].(float64)
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	var session models.Session
+	initializers.DB.Where("id = ? AND user_id = ?", uint(sessionID), user.ID).Find(&session)
+
+	if session.ID == 0 || session.RevokedAt != nil || time.Now().After(session.ExpiresAt) {
+		return nil, http.StatusUnauthorized
+	}
+
+	// the profile in the token has to be the one currently selected in the session
+	profileID, _ := claims["pid"].(float64)
+	if uint(profileID) != session.ProfileID {
+		return nil, http.StatusUnauthorized
+	}
+
+	if time.Since(session.LastSeenAt) > sessionTouchInterval {
+		initializers.DB.Model(&session).Updates(map[string]interface{}{
+			"last_seen_at": time.Now(),
+			"ip_address":   c.ClientIP(),
+		})
+	}
+
+	authUser := AuthUser{
+		ID:               user.ID,
+		Email:            user.Email,
+		Role:             user.RoleID,
+		EmailVerified:    user.IsEmailVerified(),
+		TwoFactorEnabled: user.IsTwoFactorEnabled(),
+		Permissions:      rolePermissions(user.RoleID),
+		TokenID:          tokenID,
+		SessionID:        session.ID,
+		ProfileID:        session.ProfileID,
+	}
 
-		if user.Role != 1 {
-			c.AbortWithStatusJSON(http.StatusForbidden, "forbidden")
-			return
+	if user.ParentalAgeCategoryID != nil &&
+		(session.ParentalUnlockedUntil == nil || time.Now().After(*session.ParentalUnlockedUntil)) {
+		authUser.ParentalRestricted = true
+		authUser.ParentalAgeCategoryID = *user.ParentalAgeCategoryID
+	}
+
+	// kids profiles stay restricted even without parental controls, and keep
+	// their own limit when the account allows more
+	if session.ProfileID != 0 {
+		var profile models.Profile
+		initializers.DB.Find(&profile, session.ProfileID)
+
+		if profile.IsKids {
+			kidsID := kidsAgeCategoryID()
+			if !authUser.ParentalRestricted || isStricterAgeCategory(kidsID, authUser.ParentalAgeCategoryID) {
+				authUser.ParentalAgeCategoryID = kidsID
+			}
+			authUser.ParentalRestricted = true
 		}
+	}
 
-		c.Next()
+	return &authUser, http.StatusOK
+}
+
+// kidsAgeCategoryID returns the highest age category kids profiles may see:
+// the highest one rated up to KIDS_MAX_AGE, or the lowest rated one when all
+// of them are above it. It is 0 when no category is rated, which hides the
+// whole catalog.
+func kidsAgeCategoryID() uint {
+	maxAge := defaultKidsMaxAge
+	if value, err := strconv.Atoi(os.Getenv("KIDS_MAX_AGE")); err == nil && value >= 0 {
+		maxAge = value
+	}
+
+	var ageCategory models.AgeCategory
+	initializers.DB.Where("minimum_age IS NOT NULL AND minimum_age <= ?", maxAge).
+		Order("minimum_age DESC").Limit(1).Find(&ageCategory)
+
+	if ageCategory.ID == 0 {
+		initializers.DB.Where("minimum_age IS NOT NULL").
+			Order("minimum_age").Limit(1).Find(&ageCategory)
+	}
+
+	return ageCategory.ID
+}
+
+// isStricterAgeCategory reports whether age category a shows fewer movies
+// than b. An unknown or unrated category shows none.
+func isStricterAgeCategory(a, b uint) bool {
+	var ageCategories []models.AgeCategory
+	initializers.DB.Unscoped().Where("id IN ?", []uint{a, b}).Find(&ageCategories)
+
+	minimumAges := map[uint]*int{}
+	for _, ageCategory := range ageCategories {
+		minimumAges[ageCategory.ID] = ageCategory.MinimumAge
+	}
+
+	if minimumAges[b] == nil {
+		return false
 	}
+	return minimumAges[a] == nil || *minimumAges[a] < *minimumAges[b]
 }
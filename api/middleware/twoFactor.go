@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/diana-gemini/ozinshe/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireTwoFactor blocks accounts without two-factor authentication when it
+// is made mandatory with TWO_FACTOR_REQUIRED_FOR_ADMIN.
+func RequireTwoFactor() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authUser, exists := c.Get("authUser")
+		if !exists {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, "failed to get the user")
+			return
+		}
+
+		user, ok := authUser.(AuthUser)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		if config.TwoFactorRequiredForAdmin() && !user.TwoFactorEnabled {
+			c.AbortWithStatusJSON(http.StatusForbidden, "two-factor authentication required")
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/diana-gemini/ozinshe/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireVerifiedEmail blocks users who have not confirmed their email address
+// unless email verification is switched off.
+func RequireVerifiedEmail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authUser, exists := c.Get("authUser")
+		if !exists {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, "failed to get the user")
+			return
+		}
+
+		user, ok := authUser.(AuthUser)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		if !user.EmailVerified && config.EmailVerificationMode() != config.EmailVerificationOff {
+			c.AbortWithStatusJSON(http.StatusForbidden, "email is not verified")
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -3,61 +3,175 @@ package router
 import (
 	"github.com/diana-gemini/ozinshe/api/controllers"
 	"github.com/diana-gemini/ozinshe/api/middleware"
+	"github.com/diana-gemini/ozinshe/internal/models"
+	"github.com/diana-gemini/ozinshe/internal/ratelimit"
 
 	"github.com/gin-gonic/gin"
 )
 
 func GetRoute(r *gin.Engine) {
-	r.POST("/signup", controllers.Signup)
-	r.POST("/login", controllers.Login)
-	r.POST("/passwordrecover", controllers.PasswordRecover)
-	r.POST("/resetpassword", controllers.ResetPassword)
-
-	r.Use(middleware.RequireAuth)
-	r.POST("/logout", controllers.Logout)
-	r.GET("/editprofile", controllers.EditUserProfile)
-	r.PUT("/updateprofile", controllers.UpdateUserProfile)
-	r.POST("/changepassword", controllers.ChangePassword)
-	r.GET("/home", controllers.Home)
-	r.GET("/trends", controllers.GetTrends)
-	r.GET("/newprojects", controllers.GetNewprojects)
-	r.GET("/telehikaya", controllers.GetTelehikaya)
-	r.GET("/horor", controllers.Horor)
-	r.GET("/anime", controllers.Anime)
-	r.GET("/search", controllers.Search)
-	r.GET("/all", controllers.GetAllMovies)
-	r.GET("/movie/:id", controllers.GetMovieByID)
-	r.GET("/movie/:id/series/:seasonid/:seriesid", controllers.GetMovieSeriesByID)
-	r.POST("/movie/:id/favorite", controllers.AddMovieToFavorite)
-	r.DELETE("/movie/:id/favorite", controllers.DeleteMovieFromFavorite)
-	r.GET("/movie/favorite", controllers.GetAllFavoriteMovies)
+	rateLimitStore := ratelimit.NewMemoryStore()
+	authLimit := ratelimit.LimitFromEnv("RATE_LIMIT_AUTH", ratelimit.Limit{PerMinute: 10, Burst: 5})
+	catalogLimit := ratelimit.LimitFromEnv("RATE_LIMIT_CATALOG", ratelimit.Limit{PerMinute: 120, Burst: 30})
+	adminLimit := ratelimit.LimitFromEnv("RATE_LIMIT_ADMIN", ratelimit.Limit{PerMinute: 60, Burst: 20})
+
+	r.GET("/.well-known/jwks.json", controllers.JWKS)
+
+	auth := r.Group("/")
+	auth.Use(middleware.RateLimit(rateLimitStore, "auth", authLimit))
+	{
+		auth.POST("/signup", controllers.Signup)
+		auth.POST("/login", controllers.Login)
+		auth.POST("/login/2fa", controllers.LoginTwoFactor)
+		auth.GET("/oidc/login", controllers.OIDCLogin)
+		auth.GET("/oidc/callback", controllers.OIDCCallback)
+		auth.POST("/token/refresh", controllers.RefreshToken)
+		auth.POST("/passwordrecover", controllers.PasswordRecover)
+		auth.POST("/resetpassword", controllers.ResetPassword)
+		auth.GET("/verifyemail", controllers.VerifyEmail)
+		auth.POST("/verifyemail/resend", controllers.ResendVerificationEmail)
+		auth.GET("/changeemail/confirm", controllers.ConfirmEmailChange)
+	}
+
+	// the catalog can be browsed without an account; playback links stay
+	// behind authentication
+	public := r.Group("/")
+	public.Use(middleware.OptionalAuth, middleware.RateLimit(rateLimitStore, "catalog", catalogLimit))
+	{
+		public.GET("/home", controllers.Home)
+		public.GET("/trends", controllers.GetTrends)
+		public.GET("/newprojects", controllers.GetNewprojects)
+		public.GET("/telehikaya", controllers.GetTelehikaya)
+		public.GET("/horor", controllers.Horor)
+		public.GET("/anime", controllers.Anime)
+		public.GET("/search", controllers.Search)
+		public.GET("/all", controllers.GetAllMovies)
+		public.GET("/movie/:id", controllers.GetMovieByID)
+		public.GET("/keywords", controllers.GetKeywords)
+		public.GET("/keywords/:id", controllers.GetKeywordMovies)
+		public.GET("/people", controllers.GetPeople)
+		public.GET("/person/:id", controllers.GetPersonByID)
+	}
+
+	// account routes take user tokens only; API keys are limited to the
+	// catalog and admin routes their scopes cover
+	account := r.Group("/")
+	account.Use(middleware.RequireAuth)
+	{
+		account.POST("/logout", controllers.Logout)
+		account.GET("/sessions", controllers.ListSessions)
+		account.DELETE("/sessions", controllers.RevokeOtherSessions)
+		account.DELETE("/sessions/:id", controllers.RevokeSession)
+		account.GET("/account/export", controllers.ExportAccount)
+		account.POST("/account/delete", controllers.DeleteAccount)
+		account.POST("/account/delete/cancel", controllers.CancelAccountDeletion)
+		account.GET("/profiles", controllers.GetProfiles)
+		account.POST("/profiles", controllers.CreateProfile)
+		account.PUT("/profiles/:id", controllers.UpdateProfile)
+		account.DELETE("/profiles/:id", controllers.DeleteProfile)
+		account.POST("/profiles/:id/select", controllers.SelectProfile)
+		pinLimit := middleware.RateLimit(rateLimitStore, "parental", authLimit)
+		account.GET("/parentalcontrols", controllers.GetParentalControls)
+		account.PUT("/parentalcontrols", pinLimit, controllers.SetParentalControls)
+		account.DELETE("/parentalcontrols", pinLimit, controllers.DisableParentalControls)
+		account.POST("/parentalcontrols/unlock", pinLimit, controllers.UnlockParentalControls)
+		account.POST("/parentalcontrols/lock", controllers.LockParentalControls)
+		account.GET("/editprofile", controllers.EditUserProfile)
+		account.PUT("/updateprofile", middleware.RequireVerifiedEmail(), controllers.UpdateUserProfile)
+		account.POST("/changepassword", middleware.RequireVerifiedEmail(), controllers.ChangePassword)
+		account.POST("/changeemail", middleware.RequireVerifiedEmail(), controllers.ChangeEmail)
+		account.POST("/2fa/enroll", controllers.EnrollTwoFactor)
+		account.POST("/2fa/enable", controllers.EnableTwoFactor)
+		account.POST("/2fa/disable", controllers.DisableTwoFactor)
+	}
+
+	catalog := r.Group("/")
+	catalog.Use(middleware.RequireAuthOrAPIKey, middleware.RateLimit(rateLimitStore, "catalog", catalogLimit), middleware.RequirePermission(models.PermissionCatalogRead))
+	{
+		catalog.GET("/movie/:id/series/:seasonnumber/:episodenumber", controllers.GetMovieSeriesByID)
+		catalog.POST("/movie/:id/favorite", middleware.RequireVerifiedEmail(), middleware.RequireProfile(), controllers.AddMovieToFavorite)
+		catalog.DELETE("/movie/:id/favorite", middleware.RequireVerifiedEmail(), middleware.RequireProfile(), controllers.DeleteMovieFromFavorite)
+		catalog.GET("/movie/favorite", middleware.RequireProfile(), controllers.GetAllFavoriteMovies)
+	}
 
 	admin := r.Group("/admin")
-	admin.Use(middleware.IsAdmin())
+	admin.Use(middleware.RequireAuthOrAPIKey, middleware.RateLimit(rateLimitStore, "admin", adminLimit),
+		middleware.RequireVerifiedEmail(), middleware.RequireTwoFactor())
 	{
-		admin.POST("/category/create", controllers.CreateCategory)
-		admin.GET("/category/:id/edit", controllers.EditCategory)
-		admin.PUT("/category/:id/update", controllers.UpdateCategory)
-		admin.DELETE("/category/:id/delete", controllers.DeleteCategory)
-
-		admin.POST("/type/create", controllers.CreateTypeOfProject)
-		admin.GET("/type/:id/edit", controllers.EditTypeOfProject)
-		admin.PUT("/type/:id/update", controllers.UpdateTypeOfProject)
-		admin.DELETE("/type/:id/delete", controllers.DeleteTypeOfProject)
-
-		admin.POST("/agecategory/create", controllers.CreateAgeCategory)
-		admin.GET("/agecategory/:id/edit", controllers.EditAgeCategory)
-		admin.PUT("/agecategory/:id/update", controllers.UpdateAgeCategory)
-		admin.DELETE("/agecategory/:id/delete", controllers.DeleteAgeCategory)
-
-		admin.POST("/movie/:id/season/create", controllers.CreateSeason)
-		admin.GET("/movie/:id/season/:seasonid/edit", controllers.EditSeason)
-		admin.PUT("/movie/:id/season/:seasonid/update", controllers.UpdateSeason)
-		admin.DELETE("/movie/:id/season/:seasonid/delete", controllers.DeleteSeason)
-
-		admin.POST("/movie/create", controllers.CreateMovie)
-		admin.GET("/movie/:id/edit", controllers.EditMovie)
-		admin.PUT("/movie/:id/update", controllers.UpdateMovie)
-		admin.DELETE("/movie/:id/delete", controllers.DeleteMovie)
+		catalogWrite := middleware.RequirePermission(models.PermissionCatalogWrite)
+		movieWrite := middleware.RequirePermission(models.PermissionMovieWrite)
+		userManage := middleware.RequirePermission(models.PermissionUserManage)
+		auditRead := middleware.RequirePermission(models.PermissionAuditRead)
+		apiKeyManage := middleware.RequirePermission(models.PermissionAPIKeyManage)
+
+		admin.POST("/category/create", catalogWrite, controllers.CreateCategory)
+		admin.GET("/category/:id/edit", catalogWrite, controllers.EditCategory)
+		admin.PUT("/category/:id/update", catalogWrite, controllers.UpdateCategory)
+		admin.DELETE("/category/:id/delete", catalogWrite, controllers.DeleteCategory)
+		admin.GET("/category/:id/translations", catalogWrite, controllers.GetCategoryTranslations)
+		admin.PUT("/category/:id/translation/:locale", catalogWrite, controllers.SetCategoryTranslation)
+		admin.DELETE("/category/:id/translation/:locale", catalogWrite, controllers.DeleteCategoryTranslation)
+
+		admin.POST("/type/create", catalogWrite, controllers.CreateTypeOfProject)
+		admin.GET("/type/:id/edit", catalogWrite, controllers.EditTypeOfProject)
+		admin.PUT("/type/:id/update", catalogWrite, controllers.UpdateTypeOfProject)
+		admin.DELETE("/type/:id/delete", catalogWrite, controllers.DeleteTypeOfProject)
+		admin.GET("/type/:id/translations", catalogWrite, controllers.GetTypeTranslations)
+		admin.PUT("/type/:id/translation/:locale", catalogWrite, controllers.SetTypeTranslation)
+		admin.DELETE("/type/:id/translation/:locale", catalogWrite, controllers.DeleteTypeTranslation)
+
+		admin.POST("/agecategory/create", catalogWrite, controllers.CreateAgeCategory)
+		admin.GET("/agecategory/:id/edit", catalogWrite, controllers.EditAgeCategory)
+		admin.PUT("/agecategory/:id/update", catalogWrite, controllers.UpdateAgeCategory)
+		admin.DELETE("/agecategory/:id/delete", catalogWrite, controllers.DeleteAgeCategory)
+		admin.GET("/agecategory/:id/translations", catalogWrite, controllers.GetAgeCategoryTranslations)
+		admin.PUT("/agecategory/:id/translation/:locale", catalogWrite, controllers.SetAgeCategoryTranslation)
+		admin.DELETE("/agecategory/:id/translation/:locale", catalogWrite, controllers.DeleteAgeCategoryTranslation)
+
+		admin.POST("/keyword/create", catalogWrite, controllers.CreateKeyword)
+		admin.PUT("/keyword/:id/update", catalogWrite, controllers.UpdateKeyword)
+		admin.DELETE("/keyword/:id/delete", catalogWrite, controllers.DeleteKeyword)
+
+		admin.POST("/person/create", catalogWrite, controllers.CreatePerson)
+		admin.GET("/person/:id/edit", catalogWrite, controllers.EditPerson)
+		admin.PUT("/person/:id/update", catalogWrite, controllers.UpdatePerson)
+		admin.DELETE("/person/:id/delete", catalogWrite, controllers.DeletePerson)
+
+		admin.POST("/movie/:id/season/create", movieWrite, controllers.CreateSeason)
+		admin.GET("/movie/:id/season/:seasonnumber/edit", movieWrite, controllers.EditSeason)
+		admin.PUT("/movie/:id/season/:seasonnumber/update", movieWrite, controllers.UpdateSeason)
+		admin.DELETE("/movie/:id/season/:seasonnumber/delete", movieWrite, controllers.DeleteSeason)
+		admin.PUT("/movie/:id/season/reorder", movieWrite, controllers.ReorderSeasons)
+
+		admin.POST("/movie/:id/season/:seasonnumber/episode/create", movieWrite, controllers.CreateEpisode)
+		admin.PUT("/movie/:id/season/:seasonnumber/episode/:episodeid/update", movieWrite, controllers.UpdateEpisode)
+		admin.DELETE("/movie/:id/season/:seasonnumber/episode/:episodeid/delete", movieWrite, controllers.DeleteEpisode)
+
+		admin.POST("/movie/:id/credit/create", movieWrite, controllers.CreateCredit)
+		admin.PUT("/movie/:id/credit/:creditid/update", movieWrite, controllers.UpdateCredit)
+		admin.DELETE("/movie/:id/credit/:creditid/delete", movieWrite, controllers.DeleteCredit)
+
+		admin.POST("/movie/create", movieWrite, controllers.CreateMovie)
+		admin.GET("/movie/:id/edit", movieWrite, controllers.EditMovie)
+		admin.PUT("/movie/:id/update", movieWrite, controllers.UpdateMovie)
+		admin.DELETE("/movie/:id/delete", movieWrite, controllers.DeleteMovie)
+		admin.GET("/movie/:id/translations", movieWrite, controllers.GetMovieTranslations)
+		admin.PUT("/movie/:id/translation/:locale", movieWrite, controllers.SetMovieTranslation)
+		admin.DELETE("/movie/:id/translation/:locale", movieWrite, controllers.DeleteMovieTranslation)
+
+		admin.GET("/users", userManage, controllers.GetUsers)
+		admin.GET("/user/:id", userManage, controllers.GetUser)
+		admin.GET("/user/:id/favorites", userManage, controllers.GetUserFavorites)
+		admin.POST("/user/:id/suspend", userManage, controllers.SuspendUser)
+		admin.POST("/user/:id/unsuspend", userManage, controllers.UnsuspendUser)
+		admin.PUT("/user/:id/role", userManage, controllers.ChangeUserRole)
+		admin.POST("/user/:id/resetpassword", userManage, controllers.ForcePasswordReset)
+		admin.POST("/user/:id/unlock", userManage, controllers.UnlockUser)
+
+		admin.GET("/audit", auditRead, controllers.GetAuditLogs)
+
+		admin.GET("/apikeys", apiKeyManage, controllers.GetAPIKeys)
+		admin.POST("/apikeys", apiKeyManage, controllers.CreateAPIKey)
+		admin.DELETE("/apikeys/:id", apiKeyManage, controllers.RevokeAPIKey)
 	}
 }
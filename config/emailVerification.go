@@ -0,0 +1,21 @@
+package config
+
+import "os"
+
+const (
+	// EmailVerificationOff lets unverified users do everything.
+	EmailVerificationOff = "off"
+	// EmailVerificationBrowse lets unverified users log in and browse the catalog only.
+	EmailVerificationBrowse = "browse"
+	// EmailVerificationRequired does not let unverified users log in.
+	EmailVerificationRequired = "required"
+)
+
+func EmailVerificationMode() string {
+	switch mode := os.Getenv("EMAIL_VERIFICATION_MODE"); mode {
+	case EmailVerificationOff, EmailVerificationRequired:
+		return mode
+	default:
+		return EmailVerificationBrowse
+	}
+}
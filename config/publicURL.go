@@ -0,0 +1,24 @@
+package config
+
+import (
+	"net/url"
+	"os"
+	"strings"
+)
+
+const defaultPublicBaseURL = "http://localhost:3000"
+
+// PublicURL builds an absolute link to the given path on PUBLIC_BASE_URL.
+func PublicURL(path string, query url.Values) string {
+	baseURL := os.Getenv("PUBLIC_BASE_URL")
+	if baseURL == "" {
+		baseURL = defaultPublicBaseURL
+	}
+
+	link := strings.TrimRight(baseURL, "/") + "/" + strings.TrimLeft(path, "/")
+	if len(query) > 0 {
+		link += "?" + query.Encode()
+	}
+
+	return link
+}
@@ -0,0 +1,18 @@
+package config
+
+import "os"
+
+const defaultTwoFactorIssuer = "Ozinshe"
+
+// TwoFactorRequiredForAdmin reports whether the admin routes are closed to
+// accounts without two-factor authentication.
+func TwoFactorRequiredForAdmin() bool {
+	return os.Getenv("TWO_FACTOR_REQUIRED_FOR_ADMIN") == "true"
+}
+
+func TwoFactorIssuer() string {
+	if issuer := os.Getenv("TWO_FACTOR_ISSUER"); issuer != "" {
+		return issuer
+	}
+	return defaultTwoFactorIssuer
+}
@@ -0,0 +1,18 @@
+package initializers
+
+import (
+	"log"
+
+	"github.com/diana-gemini/ozinshe/internal/mailer"
+)
+
+var Mailer mailer.Mailer
+
+func ConnectMailer() {
+	var err error
+	Mailer, err = mailer.NewFromEnv()
+
+	if err != nil {
+		log.Fatal("Mailer configuration failed: ", err)
+	}
+}
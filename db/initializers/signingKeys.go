@@ -0,0 +1,18 @@
+package initializers
+
+import (
+	"log"
+
+	"github.com/diana-gemini/ozinshe/internal/jwtkeys"
+)
+
+var SigningKeys *jwtkeys.KeySet
+
+func LoadSigningKeys() {
+	var err error
+	SigningKeys, err = jwtkeys.LoadFromEnv()
+
+	if err != nil {
+		log.Fatal("Loading signing keys failed: ", err)
+	}
+}
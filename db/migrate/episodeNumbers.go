@@ -0,0 +1,38 @@
+package main
+
+import (
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// HasLegacyEpisodes reports whether the episodes are still addressed by their
+// place in the season instead of a number.
+func HasLegacyEpisodes() bool {
+	return initializers.DB.Migrator().HasTable(&models.Video{}) &&
+		!initializers.DB.Migrator().HasColumn(&models.Video{}, "episode_number")
+}
+
+// NumberEpisodes numbers the existing episodes of every season in the order
+// they were addressed before, and orders them the same way, so that
+// AutoMigrate can add the unique index.
+func NumberEpisodes() error {
+	return initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Migrator().AddColumn(&models.Video{}, "EpisodeNumber"); err != nil {
+			return err
+		}
+
+		if !tx.Migrator().HasColumn(&models.Video{}, "position") {
+			if err := tx.Migrator().AddColumn(&models.Video{}, "Position"); err != nil {
+				return err
+			}
+		}
+
+		return tx.Exec(`
+			UPDATE videos SET episode_number = numbered.number, position = numbered.number
+			FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY season_id ORDER BY id) AS number FROM videos) AS numbered
+			WHERE videos.id = numbered.id
+		`).Error
+	})
+}
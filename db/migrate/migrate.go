@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/diana-gemini/ozinshe/config"
 	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
 	"github.com/diana-gemini/ozinshe/internal/models"
 
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
 func init() {
@@ -28,14 +31,157 @@ func main() {
 	// 	log.Fatal("Table dropping failed")
 	// }
 
+	verifyExistingUsers := !initializers.DB.Migrator().HasColumn(&models.User{}, "email_verified_at")
+	rateAgeCategories := !initializers.DB.Migrator().HasColumn(&models.AgeCategory{}, "minimum_age")
+
+	legacyMovieMetadata := HasLegacyMovieMetadata()
+	legacyMovieCredits := HasLegacyMovieCredits()
+	if legacyMovieMetadata {
+		if err := PrepareMovieMetadata(); err != nil {
+			log.Fatal("Preparing movie metadata failed")
+		}
+	}
+
+	if HasLegacySeasons() {
+		if err := NumberSeasons(); err != nil {
+			log.Fatal("Migration of season numbers failed")
+		}
+	}
+
+	if HasLegacyEpisodes() {
+		if err := NumberEpisodes(); err != nil {
+			log.Fatal("Migration of episode numbers failed")
+		}
+	}
+
 	err := initializers.DB.AutoMigrate(models.User{}, models.AgeCategory{}, models.Category{}, models.Season{},
-		models.Type{}, models.Movie{}, models.Screenshot{}, models.Favorite{}, models.Video{})
+		models.Type{}, models.Movie{}, models.Screenshot{}, models.Favorite{}, models.Video{},
+		models.Session{}, models.RefreshToken{}, models.RevokedToken{}, models.PasswordResetToken{},
+		models.Role{}, models.Permission{}, models.LoginThrottle{}, models.PasswordHistory{},
+		models.RecoveryCode{}, models.UserIdentity{}, models.OAuthState{}, models.AuditLog{},
+		models.Profile{}, models.APIKey{}, models.Keyword{}, models.Person{}, models.Credit{},
+		models.MovieTranslation{}, models.CategoryTranslation{}, models.TypeTranslation{}, models.AgeCategoryTranslation{})
 
 	if err != nil {
 		log.Fatal("Migration failed")
 	}
 
+	// users created before email verification existed are trusted as verified
+	if verifyExistingUsers {
+		if err := initializers.DB.Model(&models.User{}).Where("email_verified_at IS NULL").
+			Update("email_verified_at", gorm.Expr("created_at")).Error; err != nil {
+			log.Fatal("Migration of verified emails failed")
+		}
+	}
+
+	// age categories are named like "12+" or "0-13"; the first number is the
+	// minimum age, and the rest is left for the admins to rate
+	if rateAgeCategories {
+		if err := initializers.DB.Model(&models.AgeCategory{}).
+			Where("minimum_age IS NULL AND age_category_name ~ '[0-9]'").
+			Update("minimum_age", gorm.Expr("CAST(substring(age_category_name from '[0-9]+') AS integer)")).Error; err != nil {
+			log.Fatal("Migration of age categories failed")
+		}
+	}
+
+	if legacyMovieMetadata {
+		if err := MigrateMovieMetadata(); err != nil {
+			log.Fatal("Migration of movie metadata failed")
+		}
+	}
+
+	if legacyMovieCredits {
+		if err := MigrateMovieCredits(); err != nil {
+			log.Fatal("Migration of movie credits failed")
+		}
+	}
+
+	if err := ProtectAuditLog(); err != nil {
+		log.Fatal("Protecting audit log failed")
+	}
+
+	if err := SeedRoles(); err != nil {
+		log.Fatal("Seeding roles failed")
+	}
+
 	CreateAdmin()
+
+	if err := CreateDefaultProfiles(); err != nil {
+		log.Fatal("Creating default profiles failed")
+	}
+}
+
+// CreateDefaultProfiles gives every account without profiles its first one
+// and moves the favorites saved before profiles existed to it.
+func CreateDefaultProfiles() error {
+	var users []models.User
+	err := initializers.DB.Where("NOT EXISTS (SELECT 1 FROM profiles WHERE profiles.user_id = users.id)").
+		Find(&users).Error
+	if err != nil {
+		return err
+	}
+
+	for i := range users {
+		err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+			if err := helpers.CreateDefaultProfile(tx, &users[i]); err != nil {
+				return err
+			}
+
+			var profile models.Profile
+			if err := tx.Where("user_id = ?", users[i].ID).First(&profile).Error; err != nil {
+				return err
+			}
+
+			return tx.Model(&models.Favorite{}).
+				Where("user_id = ? AND (profile_id IS NULL OR profile_id = 0)", users[i].ID).
+				Update("profile_id", profile.ID).Error
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ProtectAuditLog makes the audit log append-only by rejecting updates and
+// deletes in the database itself.
+func ProtectAuditLog() error {
+	return initializers.DB.Exec(`
+		CREATE OR REPLACE FUNCTION audit_logs_append_only() RETURNS trigger AS $$
+		BEGIN
+			RAISE EXCEPTION 'audit_logs is append-only';
+		END;
+		$$ LANGUAGE plpgsql;
+
+		DROP TRIGGER IF EXISTS audit_logs_append_only ON audit_logs;
+		CREATE TRIGGER audit_logs_append_only BEFORE UPDATE OR DELETE ON audit_logs
+			FOR EACH ROW EXECUTE FUNCTION audit_logs_append_only();
+	`).Error
+}
+
+func SeedRoles() error {
+	for _, defaultRole := range models.DefaultRoles {
+		var permissions []models.Permission
+		for _, name := range defaultRole.Permissions {
+			permission := models.Permission{Name: name}
+			if err := initializers.DB.Where(permission).FirstOrCreate(&permission).Error; err != nil {
+				return err
+			}
+			permissions = append(permissions, permission)
+		}
+
+		role := models.Role{Name: defaultRole.Name}
+		if err := initializers.DB.Where(role).FirstOrCreate(&role).Error; err != nil {
+			return err
+		}
+
+		if err := initializers.DB.Model(&role).Association("Permissions").Replace(permissions); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func CreateAdmin() {
@@ -44,10 +190,18 @@ func CreateAdmin() {
 		fmt.Println("Failed to hash admin assword")
 		return
 	}
+	var role models.Role
+	if err := initializers.DB.First(&role, "name = ?", models.RoleAdmin).Error; err != nil {
+		fmt.Println("Admin role not found")
+		return
+	}
+
+	verifiedAt := time.Now()
 	admin := models.User{
-		Email:    os.Getenv("ADMIN_EMAIL"),
-		Password: string(hashPassword),
-		RoleID:   1,
+		Email:           os.Getenv("ADMIN_EMAIL"),
+		Password:        string(hashPassword),
+		RoleID:          role.ID,
+		EmailVerifiedAt: &verifiedAt,
 	}
 
 	result := initializers.DB.Create(&admin)
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// HasLegacyMovieCredits reports whether the directors and producers of the
+// movies are still kept as comma separated names.
+func HasLegacyMovieCredits() bool {
+	return initializers.DB.Migrator().HasColumn("movies", "director")
+}
+
+// MigrateMovieCredits turns the director and producer names of the existing
+// movies into people with credits, then drops the name columns. Equal names
+// become the same person.
+func MigrateMovieCredits() error {
+	type legacyMovie struct {
+		ID       uint
+		Director string
+		Producer string
+	}
+
+	return initializers.DB.Transaction(func(tx *gorm.DB) error {
+		var movies []legacyMovie
+		if err := tx.Table("movies").Select("id, director, producer").Scan(&movies).Error; err != nil {
+			return err
+		}
+
+		people := map[string]uint{}
+
+		for _, movie := range movies {
+			position := 0
+
+			for _, credits := range []struct {
+				role  string
+				names string
+			}{
+				{models.CreditRoleDirector, movie.Director},
+				{models.CreditRoleProducer, movie.Producer},
+			} {
+				for _, name := range strings.Split(credits.names, ",") {
+					name = strings.Join(strings.Fields(name), " ")
+					if name == "" {
+						continue
+					}
+
+					personID, err := findOrCreatePerson(tx, people, name)
+					if err != nil {
+						return err
+					}
+
+					credit := models.Credit{
+						MovieID:  movie.ID,
+						PersonID: personID,
+						Role:     credits.role,
+						Position: position,
+					}
+					if err := tx.Create(&credit).Error; err != nil {
+						return err
+					}
+					position++
+				}
+			}
+		}
+
+		return tx.Exec("ALTER TABLE movies DROP COLUMN director, DROP COLUMN producer").Error
+	})
+}
+
+func findOrCreatePerson(tx *gorm.DB, people map[string]uint, name string) (uint, error) {
+	if id, ok := people[name]; ok {
+		return id, nil
+	}
+
+	var person models.Person
+	if err := tx.Where(models.Person{Name: name}).FirstOrCreate(&person).Error; err != nil {
+		return 0, err
+	}
+
+	people[name] = person.ID
+	return person.ID, nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
+
+	"gorm.io/gorm"
+)
+
+var yearPattern = regexp.MustCompile(`\d{4}`)
+
+// HasLegacyMovieMetadata reports whether the movies table still keeps year,
+// timing and keywords as free-form text.
+func HasLegacyMovieMetadata() bool {
+	return initializers.DB.Migrator().HasColumn("movies", "timing")
+}
+
+// PrepareMovieMetadata moves the text year out of the way of the numeric
+// column added by AutoMigrate.
+func PrepareMovieMetadata() error {
+	if initializers.DB.Migrator().HasColumn("movies", "year_text") {
+		return nil
+	}
+	return initializers.DB.Exec("ALTER TABLE movies RENAME COLUMN year TO year_text").Error
+}
+
+// MigrateMovieMetadata parses the free-form year, timing and keywords of the
+// existing movies into the typed columns and the keyword table, then drops
+// the text columns. Values that cannot be parsed are left at 0 and logged.
+func MigrateMovieMetadata() error {
+	type legacyMovie struct {
+		ID       uint
+		YearText string
+		Timing   string
+		Keywords string
+	}
+
+	return initializers.DB.Transaction(func(tx *gorm.DB) error {
+		var movies []legacyMovie
+		if err := tx.Table("movies").Select("id, year_text, timing, keywords").Scan(&movies).Error; err != nil {
+			return err
+		}
+
+		for _, movie := range movies {
+			year := parseYear(movie.YearText)
+			if year == 0 {
+				log.Printf("movie %d: cannot parse year %q", movie.ID, movie.YearText)
+			}
+
+			runtime := helpers.ParseRuntime(movie.Timing)
+			if runtime == 0 {
+				log.Printf("movie %d: cannot parse timing %q", movie.ID, movie.Timing)
+			}
+
+			err := tx.Table("movies").Where("id = ?", movie.ID).Updates(map[string]interface{}{
+				"year":    year,
+				"runtime": runtime,
+			}).Error
+			if err != nil {
+				return err
+			}
+
+			keywords, err := helpers.FindOrCreateKeywords(tx, helpers.ParseKeywords(movie.Keywords))
+			if err != nil {
+				return err
+			}
+
+			for _, keyword := range keywords {
+				err := tx.Exec("INSERT INTO movie_keywords (movie_id, keyword_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+					movie.ID, keyword.ID).Error
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return tx.Exec("ALTER TABLE movies DROP COLUMN year_text, DROP COLUMN timing, DROP COLUMN keywords").Error
+	})
+}
+
+// parseYear returns the first plausible four-digit year in the text.
+func parseYear(text string) int {
+	for _, match := range yearPattern.FindAllString(text, -1) {
+		year, _ := strconv.Atoi(match)
+		if year >= 1888 && year <= time.Now().Year()+5 {
+			return year
+		}
+	}
+	return 0
+}
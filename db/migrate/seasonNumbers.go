@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// HasLegacySeasons reports whether the seasons are still addressed by their
+// place in the movie instead of a number.
+func HasLegacySeasons() bool {
+	return initializers.DB.Migrator().HasTable(&models.Season{}) &&
+		!initializers.DB.Migrator().HasColumn(&models.Season{}, "number")
+}
+
+// NumberSeasons numbers the existing seasons of every movie in the order they
+// were addressed before, so that AutoMigrate can add the unique index.
+func NumberSeasons() error {
+	return initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Migrator().AddColumn(&models.Season{}, "Number"); err != nil {
+			return err
+		}
+
+		return tx.Exec(`
+			UPDATE seasons SET number = numbered.number
+			FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY movie_id ORDER BY id) AS number FROM seasons) AS numbered
+			WHERE seasons.id = numbered.id
+		`).Error
+	})
+}
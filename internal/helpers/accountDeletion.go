@@ -0,0 +1,127 @@
+package helpers
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"gorm.io/gorm"
+)
+
+const defaultAccountDeletionGracePeriod = 30 * 24 * time.Hour
+
+// AccountDeletionGracePeriod is how long a deletion request can still be
+// cancelled before the account data is removed.
+func AccountDeletionGracePeriod() time.Duration {
+	return durationFromEnv("ACCOUNT_DELETION_GRACE_PERIOD", defaultAccountDeletionGracePeriod)
+}
+
+// ScheduleAccountDeletion marks the account for deletion after the grace
+// period and ends all of its sessions.
+func ScheduleAccountDeletion(user *models.User) (time.Time, error) {
+	deleteAt := time.Now().Add(AccountDeletionGracePeriod())
+
+	if err := initializers.DB.Model(user).Update("deletion_scheduled_at", deleteAt).Error; err != nil {
+		return time.Time{}, err
+	}
+
+	if err := RevokeUserTokens(user.ID); err != nil {
+		return time.Time{}, err
+	}
+
+	return deleteAt, nil
+}
+
+func CancelAccountDeletion(user *models.User) error {
+	return initializers.DB.Model(user).Update("deletion_scheduled_at", nil).Error
+}
+
+// PurgeDeletedAccounts deletes every account whose grace period is over.
+func PurgeDeletedAccounts() error {
+	var userIDs []uint
+	if err := initializers.DB.Model(&models.User{}).
+		Where("deletion_scheduled_at <= ?", time.Now()).
+		Pluck("id", &userIDs).Error; err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		if err := DeleteAccount(userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StartAccountPurge runs PurgeDeletedAccounts in the background at the given interval.
+func StartAccountPurge(interval time.Duration) {
+	go func() {
+		for {
+			if err := PurgeDeletedAccounts(); err != nil {
+				log.Println("account purge failed:", err)
+			}
+			time.Sleep(interval)
+		}
+	}()
+}
+
+// DeleteAccount removes the personal data of the user in one transaction.
+// Favorites, profiles, sessions, refresh and password reset tokens, password
+// history, recovery codes, linked sign-in identities and login throttling are
+// hard-deleted. The user row itself is not: it is anonymised and soft-deleted,
+// because the append-only audit log and the API keys the user created still
+// refer to it. A soft-deleted owner makes those API keys unusable.
+func DeleteAccount(userID uint) error {
+	return initializers.DB.Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := tx.First(&user, userID).Error; err != nil {
+			return err
+		}
+
+		personalData := []interface{}{
+			&models.Favorite{},
+			&models.Profile{},
+			&models.Session{},
+			&models.RefreshToken{},
+			&models.PasswordResetToken{},
+			&models.PasswordHistory{},
+			&models.RecoveryCode{},
+			&models.UserIdentity{},
+		}
+
+		for _, model := range personalData {
+			if err := tx.Unscoped().Where("user_id = ?", user.ID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Unscoped().Where("key = ?", LoginAccountKey(user.Email)).Delete(&models.LoginThrottle{}).Error; err != nil {
+			return err
+		}
+
+		err := tx.Model(&user).Updates(map[string]interface{}{
+			"email":                    fmt.Sprintf("deleted-user-%d@deleted.invalid", user.ID),
+			"password":                 "",
+			"username":                 "",
+			"mobile_phone":             "",
+			"birth_date":               "",
+			"email_verified_at":        nil,
+			"verification_sent_at":     nil,
+			"two_factor_secret":        "",
+			"two_factor_enabled_at":    nil,
+			"two_factor_last_step":     0,
+			"deletion_scheduled_at":    nil,
+			"parental_age_category_id": nil,
+			"parental_pin":             "",
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Delete(&user).Error
+	})
+}
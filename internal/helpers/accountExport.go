@@ -0,0 +1,147 @@
+package helpers
+
+import (
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+)
+
+// AccountExport is everything stored about a user, as handed out by the data
+// export endpoint.
+type AccountExport struct {
+	ExportedAt time.Time
+	Profile    AccountExportProfile
+	Profiles   []AccountExportViewerProfile
+	Favorites  []AccountExportFavorite
+	Sessions   []AccountExportSession
+	Identities []AccountExportIdentity
+}
+
+type AccountExportProfile struct {
+	ID                  uint
+	Email               string
+	Username            string
+	MobilePhone         string
+	BirthDate           string
+	Role                string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	EmailVerifiedAt     *time.Time
+	TwoFactorEnabledAt  *time.Time
+	DeletionScheduledAt *time.Time
+}
+
+type AccountExportViewerProfile struct {
+	ID        uint
+	Name      string
+	Avatar    string
+	IsKids    bool
+	Language  string
+	CreatedAt time.Time
+}
+
+type AccountExportFavorite struct {
+	ProfileID     uint
+	MovieID       uint
+	NameOfProject string
+	AddedAt       time.Time
+}
+
+type AccountExportSession struct {
+	DeviceName string
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	RevokedAt  *time.Time
+}
+
+type AccountExportIdentity struct {
+	Provider  string
+	Subject   string
+	CreatedAt time.Time
+}
+
+// ExportAccount collects the data tied to the user.
+func ExportAccount(user *models.User) (*AccountExport, error) {
+	export := AccountExport{
+		ExportedAt: time.Now(),
+		Profile: AccountExportProfile{
+			ID:                  user.ID,
+			Email:               user.Email,
+			Username:            user.Username,
+			MobilePhone:         user.MobilePhone,
+			BirthDate:           user.BirthDate,
+			CreatedAt:           user.CreatedAt,
+			UpdatedAt:           user.UpdatedAt,
+			EmailVerifiedAt:     user.EmailVerifiedAt,
+			TwoFactorEnabledAt:  user.TwoFactorEnabledAt,
+			DeletionScheduledAt: user.DeletionScheduledAt,
+		},
+		Profiles:   []AccountExportViewerProfile{},
+		Favorites:  []AccountExportFavorite{},
+		Sessions:   []AccountExportSession{},
+		Identities: []AccountExportIdentity{},
+	}
+
+	var role models.Role
+	if err := initializers.DB.Find(&role, user.RoleID).Error; err != nil {
+		return nil, err
+	}
+	export.Profile.Role = role.Name
+
+	var profiles []models.Profile
+	if err := initializers.DB.Where("user_id = ?", user.ID).Order("id").Find(&profiles).Error; err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles {
+		export.Profiles = append(export.Profiles, AccountExportViewerProfile{
+			ID:        profile.ID,
+			Name:      profile.Name,
+			Avatar:    profile.Avatar,
+			IsKids:    profile.IsKids,
+			Language:  profile.Language,
+			CreatedAt: profile.CreatedAt,
+		})
+	}
+
+	err := initializers.DB.Model(&models.Favorite{}).
+		Select("favorites.profile_id, favorites.movie_id, movies.name_of_project, favorites.created_at AS added_at").
+		Joins("LEFT JOIN movies ON movies.id = favorites.movie_id").
+		Where("favorites.user_id = ?", user.ID).
+		Order("favorites.created_at").
+		Scan(&export.Favorites).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []models.Session
+	if err := initializers.DB.Where("user_id = ?", user.ID).Order("created_at").Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		export.Sessions = append(export.Sessions, AccountExportSession{
+			DeviceName: session.DeviceName,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+			RevokedAt:  session.RevokedAt,
+		})
+	}
+
+	var identities []models.UserIdentity
+	if err := initializers.DB.Where("user_id = ?", user.ID).Find(&identities).Error; err != nil {
+		return nil, err
+	}
+	for _, identity := range identities {
+		export.Identities = append(export.Identities, AccountExportIdentity{
+			Provider:  identity.Provider,
+			Subject:   identity.Subject,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+
+	return &export, nil
+}
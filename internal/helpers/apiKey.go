@@ -0,0 +1,66 @@
+package helpers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/api/middleware"
+	"github.com/diana-gemini/ozinshe/internal/apikeys"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidScope   = errors.New("invalid api key scope")
+	ErrAPIKeyNotFound = errors.New("api key not found")
+)
+
+// CreateAPIKey issues a key acting as the creator. The scopes have to be
+// permissions the creator holds. The key itself is returned only here.
+func CreateAPIKey(tx *gorm.DB, creator *middleware.AuthUser, name string, scopes []string, expiresAt *time.Time) (*models.APIKey, string, error) {
+	for _, scope := range scopes {
+		if scope == models.PermissionAPIKeyManage || !creator.HasPermission(scope) {
+			return nil, "", ErrInvalidScope
+		}
+	}
+
+	key, err := apikeys.Generate()
+	if err != nil {
+		return nil, "", err
+	}
+
+	apiKey := models.APIKey{
+		Name:      name,
+		KeyPrefix: apikeys.DisplayPrefix(key),
+		KeyHash:   apikeys.Hash(key),
+		Scopes:    scopes,
+		OwnerID:   creator.ID,
+		ExpiresAt: expiresAt,
+	}
+
+	if err := tx.Create(&apiKey).Error; err != nil {
+		return nil, "", err
+	}
+
+	return &apiKey, key, nil
+}
+
+// RevokeAPIKey stops the key from working. Revoked keys stay listed.
+func RevokeAPIKey(tx *gorm.DB, id uint) (*models.APIKey, error) {
+	var apiKey models.APIKey
+	if err := tx.First(&apiKey, id).Error; err != nil {
+		return nil, ErrAPIKeyNotFound
+	}
+
+	if apiKey.RevokedAt != nil {
+		return &apiKey, nil
+	}
+
+	now := time.Now()
+	if err := tx.Model(&apiKey).Update("revoked_at", now).Error; err != nil {
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
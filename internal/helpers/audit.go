@@ -0,0 +1,51 @@
+package helpers
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// WriteAudit records an admin change of the catalog. It must be called with
+// the transaction that makes the change, so that the entry is stored only
+// when the change is. Before is nil for creations and after is nil for
+// deletions.
+func WriteAudit(tx *gorm.DB, c *gin.Context, action, entityType string, entityID uint, before, after interface{}) error {
+	authUser := GetAuthUser(c)
+	if authUser == nil {
+		return errors.New("audit actor not found")
+	}
+
+	entry := models.AuditLog{
+		ActorID:    authUser.ID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		IPAddress:  c.ClientIP(),
+	}
+
+	if authUser.APIKeyID != 0 {
+		entry.APIKeyID = &authUser.APIKeyID
+	}
+
+	var err error
+	if entry.Before, err = auditSnapshot(before); err != nil {
+		return err
+	}
+	if entry.After, err = auditSnapshot(after); err != nil {
+		return err
+	}
+
+	return tx.Create(&entry).Error
+}
+
+func auditSnapshot(value interface{}) (json.RawMessage, error) {
+	if value == nil {
+		return nil, nil
+	}
+	return json.Marshal(value)
+}
@@ -22,3 +22,18 @@ func GetAuthUser(c *gin.Context) *middleware.AuthUser {
 
 	return nil
 }
+
+// GetOptionalAuthUser returns the user of the request, or nil for a guest on
+// a public route.
+func GetOptionalAuthUser(c *gin.Context) *middleware.AuthUser {
+	authUser, exists := c.Get("authUser")
+	if !exists {
+		return nil
+	}
+
+	if user, ok := authUser.(middleware.AuthUser); ok {
+		return &user
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package helpers
+
+import (
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/mailer"
+)
+
+// SendEmail renders the named mail template and sends it with the configured mailer.
+func SendEmail(to, subject, templateName string, data interface{}) error {
+	message, err := mailer.NewMessage(to, subject, templateName, data)
+	if err != nil {
+		return err
+	}
+
+	return initializers.Mailer.Send(message)
+}
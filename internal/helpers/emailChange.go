@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+const (
+	emailChangePurpose    = "email_change"
+	defaultEmailChangeTTL = 24 * time.Hour
+)
+
+var (
+	ErrInvalidEmailChangeToken = errors.New("invalid email change token")
+	ErrEmailTaken              = errors.New("email is already in use")
+)
+
+// GenerateEmailChangeToken signs a token that confirms the move of the account
+// from its current email to the new one. It stops working once the current
+// email has changed.
+func GenerateEmailChangeToken(userID uint, currentEmail, newEmail string) (string, error) {
+	ttl := durationFromEnv("EMAIL_CHANGE_TOKEN_TTL", defaultEmailChangeTTL)
+
+	return signPurposeToken(emailChangePurpose, ttl, jwt.MapClaims{
+		"sub":       userID,
+		"email":     currentEmail,
+		"new_email": newEmail,
+	})
+}
+
+// ParseEmailChangeToken returns the user ID, the current and the new email the
+// token was issued for.
+func ParseEmailChangeToken(tokenString string) (uint, string, string, error) {
+	claims, err := parsePurposeToken(tokenString, emailChangePurpose)
+	if err != nil {
+		return 0, "", "", ErrInvalidEmailChangeToken
+	}
+
+	userID, ok := claimUserID(claims)
+	if !ok {
+		return 0, "", "", ErrInvalidEmailChangeToken
+	}
+
+	currentEmail, ok := claims["email"].(string)
+	if !ok {
+		return 0, "", "", ErrInvalidEmailChangeToken
+	}
+
+	newEmail, ok := claims["new_email"].(string)
+	if !ok || newEmail == "" {
+		return 0, "", "", ErrInvalidEmailChangeToken
+	}
+
+	return userID, currentEmail, newEmail, nil
+}
+
+// ChangeEmail moves the user to the new, already confirmed email and ends all
+// of the user's sessions.
+func ChangeEmail(user *models.User, newEmail string) error {
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Unscoped().Model(&models.User{}).Where("email = ?", newEmail).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrEmailTaken
+		}
+
+		return tx.Model(user).Updates(map[string]interface{}{
+			"email":             newEmail,
+			"email_verified_at": time.Now(),
+		}).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	return RevokeUserTokens(user.ID)
+}
@@ -0,0 +1,46 @@
+package helpers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	emailVerificationPurpose    = "email_verification"
+	defaultEmailVerificationTTL = 24 * time.Hour
+)
+
+var ErrInvalidVerificationToken = errors.New("invalid verification token")
+
+// GenerateEmailVerificationToken signs a token that proves ownership of the
+// email address it was sent to.
+func GenerateEmailVerificationToken(userID uint, email string) (string, error) {
+	ttl := durationFromEnv("EMAIL_VERIFICATION_TOKEN_TTL", defaultEmailVerificationTTL)
+
+	return signPurposeToken(emailVerificationPurpose, ttl, jwt.MapClaims{
+		"sub":   userID,
+		"email": email,
+	})
+}
+
+// ParseEmailVerificationToken returns the user ID and email the token was issued for.
+func ParseEmailVerificationToken(tokenString string) (uint, string, error) {
+	claims, err := parsePurposeToken(tokenString, emailVerificationPurpose)
+	if err != nil {
+		return 0, "", ErrInvalidVerificationToken
+	}
+
+	userID, ok := claimUserID(claims)
+	if !ok {
+		return 0, "", ErrInvalidVerificationToken
+	}
+
+	email, ok := claims["email"].(string)
+	if !ok {
+		return 0, "", ErrInvalidVerificationToken
+	}
+
+	return userID, email, nil
+}
@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"strings"
+
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxKeywordLength keeps keywords to short tags.
+const maxKeywordLength = 50
+
+// NormalizeKeyword returns the stored form of a keyword: trimmed, lower case
+// and with single spaces. It is empty for an unusable keyword.
+func NormalizeKeyword(keyword string) string {
+	keyword = strings.ToLower(strings.Join(strings.Fields(keyword), " "))
+	if len([]rune(keyword)) > maxKeywordLength {
+		return ""
+	}
+	return keyword
+}
+
+// ParseKeywords splits a comma separated list into distinct normalised
+// keywords, keeping their order.
+func ParseKeywords(list string) []string {
+	var keywords []string
+	seen := map[string]bool{}
+
+	for _, keyword := range strings.Split(list, ",") {
+		keyword = NormalizeKeyword(keyword)
+		if keyword == "" || seen[keyword] {
+			continue
+		}
+		seen[keyword] = true
+		keywords = append(keywords, keyword)
+	}
+
+	return keywords
+}
+
+// FindOrCreateKeywords returns the keywords with the given normalised names,
+// creating the missing ones.
+func FindOrCreateKeywords(tx *gorm.DB, names []string) ([]models.Keyword, error) {
+	keywords := []models.Keyword{}
+	if len(names) == 0 {
+		return keywords, nil
+	}
+
+	for _, name := range names {
+		keyword := models.Keyword{Name: name}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&keyword).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Where("name IN ?", names).Find(&keywords).Error; err != nil {
+		return nil, err
+	}
+
+	return keywords, nil
+}
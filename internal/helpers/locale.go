@@ -0,0 +1,123 @@
+package helpers
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+const localesKey = "locales"
+
+// DefaultLocale is the locale every request falls back to before the
+// untranslated text.
+func DefaultLocale() string {
+	if locale := NormalizeLocale(os.Getenv("DEFAULT_LOCALE")); locale != "" {
+		return locale
+	}
+	return models.LocaleKazakh
+}
+
+// NormalizeLocale returns the supported locale of a language tag such as
+// "ru-RU", or "" when the language is not supported.
+func NormalizeLocale(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+
+	for _, locale := range models.Locales {
+		if tag == locale {
+			return locale
+		}
+	}
+	return ""
+}
+
+// RequestLocales returns the locales to show the catalog in, most preferred
+// first: the lang query parameter, the language of the viewer profile, the
+// Accept-Language header and the default locale.
+func RequestLocales(c *gin.Context) []string {
+	if locales, ok := c.Get(localesKey); ok {
+		return locales.([]string)
+	}
+
+	candidates := []string{c.Query("lang"), profileLanguage(c)}
+	candidates = append(candidates, acceptedLanguages(c.GetHeader("Accept-Language"))...)
+	candidates = append(candidates, DefaultLocale())
+
+	var locales []string
+	seen := map[string]bool{}
+	for _, candidate := range candidates {
+		locale := NormalizeLocale(candidate)
+		if locale == "" || seen[locale] {
+			continue
+		}
+		seen[locale] = true
+		locales = append(locales, locale)
+	}
+
+	c.Writer.Header().Add("Vary", "Accept-Language")
+	c.Set(localesKey, locales)
+
+	return locales
+}
+
+func profileLanguage(c *gin.Context) string {
+	authUser := GetOptionalAuthUser(c)
+	if authUser == nil || authUser.ProfileID == 0 {
+		return ""
+	}
+
+	var profile models.Profile
+	if err := initializers.DB.Select("language").First(&profile, authUser.ProfileID).Error; err != nil {
+		return ""
+	}
+	return profile.Language
+}
+
+// acceptedLanguages lists the languages of an Accept-Language header by
+// their quality, leaving out the refused ones.
+func acceptedLanguages(header string) []string {
+	type language struct {
+		tag     string
+		quality float64
+	}
+
+	var languages []language
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		languages = append(languages, language{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].quality > languages[j].quality
+	})
+
+	tags := make([]string, len(languages))
+	for i, language := range languages {
+		tags[i] = language.tag
+	}
+	return tags
+}
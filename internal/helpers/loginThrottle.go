@@ -0,0 +1,154 @@
+package helpers
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultLoginFreeAttempts      = 3
+	defaultLoginMaxAttempts       = 5
+	defaultLoginMaxAttemptsPerIP  = 20
+	defaultLoginLockoutDuration   = 15 * time.Minute
+	defaultLoginFailureResetAfter = time.Hour
+)
+
+func LoginAccountKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func LoginIPKey(ip string) string {
+	return "ip:" + ip
+}
+
+// ParentalPinKey throttles the parental PIN of an account, which is only
+// four digits long.
+func ParentalPinKey(userID uint) string {
+	return "parental:" + strconv.FormatUint(uint64(userID), 10)
+}
+
+// LoginRetryAfter reports how long the caller has to wait before the next
+// login attempt for any of the keys is accepted. Zero means no wait.
+func LoginRetryAfter(keys ...string) time.Duration {
+	var throttles []models.LoginThrottle
+	initializers.DB.Where("key IN ?", keys).Find(&throttles)
+
+	var wait time.Duration
+	now := time.Now()
+
+	for _, throttle := range throttles {
+		if throttle.LockedUntil != nil && throttle.LockedUntil.After(now) {
+			wait = maxDuration(wait, throttle.LockedUntil.Sub(now))
+			continue
+		}
+
+		if delay := loginDelay(throttle.Failures); delay > 0 {
+			if next := throttle.LastFailedAt.Add(delay); next.After(now) {
+				wait = maxDuration(wait, next.Sub(now))
+			}
+		}
+	}
+
+	return wait
+}
+
+// RegisterLoginFailure counts a failed attempt for the account and the IP and
+// locks whichever of them went over its limit.
+func RegisterLoginFailure(accountKey, ipKey string) error {
+	return initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := registerFailure(tx, accountKey, intFromEnv("LOGIN_MAX_ATTEMPTS", defaultLoginMaxAttempts)); err != nil {
+			return err
+		}
+
+		return registerFailure(tx, ipKey, intFromEnv("LOGIN_MAX_ATTEMPTS_PER_IP", defaultLoginMaxAttemptsPerIP))
+	})
+}
+
+// RegisterParentalPinFailure counts a wrong parental PIN of the account and
+// locks the PIN when it went over the login limit.
+func RegisterParentalPinFailure(key string) error {
+	return initializers.DB.Transaction(func(tx *gorm.DB) error {
+		return registerFailure(tx, key, intFromEnv("LOGIN_MAX_ATTEMPTS", defaultLoginMaxAttempts))
+	})
+}
+
+// ResetLoginFailures forgets failed attempts and lifts the lock for the key.
+func ResetLoginFailures(key string) error {
+	return initializers.DB.Unscoped().Where("key = ?", key).Delete(&models.LoginThrottle{}).Error
+}
+
+func registerFailure(tx *gorm.DB, key string, maxAttempts int) error {
+	now := time.Now()
+	resetBefore := now.Add(-durationFromEnv("LOGIN_FAILURE_RESET_AFTER", defaultLoginFailureResetAfter))
+
+	throttle := models.LoginThrottle{
+		Key:          key,
+		Failures:     1,
+		LastFailedAt: now,
+	}
+
+	err := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "key"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"failures":       gorm.Expr("CASE WHEN login_throttles.last_failed_at < ? THEN 1 ELSE login_throttles.failures + 1 END", resetBefore),
+			"last_failed_at": now,
+			"updated_at":     now,
+		}),
+	}).Create(&throttle).Error
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Where("key = ?", key).First(&throttle).Error; err != nil {
+		return err
+	}
+
+	if throttle.Failures < maxAttempts {
+		return nil
+	}
+
+	lockedUntil := now.Add(durationFromEnv("LOGIN_LOCKOUT_DURATION", defaultLoginLockoutDuration))
+	return tx.Model(&throttle).Update("locked_until", lockedUntil).Error
+}
+
+// loginDelay doubles the wait for every failure after the free attempts.
+func loginDelay(failures int) time.Duration {
+	extra := failures - intFromEnv("LOGIN_FREE_ATTEMPTS", defaultLoginFreeAttempts)
+	if extra < 0 {
+		return 0
+	}
+
+	lockout := durationFromEnv("LOGIN_LOCKOUT_DURATION", defaultLoginLockoutDuration)
+	if extra > 16 {
+		return lockout
+	}
+
+	delay := time.Second << extra
+	if delay > lockout {
+		return lockout
+	}
+	return delay
+}
+
+func intFromEnv(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,141 @@
+package helpers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const defaultParentalUnlockDuration = time.Hour
+
+var (
+	ErrAgeCategoryNotRated = errors.New("age category has no minimum age")
+	ErrKidsProfile         = errors.New("kids profile cannot lift parental controls")
+)
+
+// ParentalUnlockDuration is how long the PIN lifts the parental controls of
+// a session.
+func ParentalUnlockDuration() time.Duration {
+	return durationFromEnv("PARENTAL_UNLOCK_DURATION", defaultParentalUnlockDuration)
+}
+
+// ParentalAgeCategoryID returns the highest age category the request may
+// see and whether the catalog is restricted for it at all. A restricted
+// request with age category 0 may see no movie.
+func ParentalAgeCategoryID(c *gin.Context) (uint, bool) {
+	if authUser := GetOptionalAuthUser(c); authUser != nil {
+		return authUser.ParentalAgeCategoryID, authUser.ParentalRestricted
+	}
+	return 0, false
+}
+
+// SetParentalControls restricts the account to the given age category and
+// replaces the PIN. Unlocked sessions are locked again.
+func SetParentalControls(user *models.User, ageCategoryID uint, pin string) error {
+	var ageCategory models.AgeCategory
+	if err := initializers.DB.First(&ageCategory, ageCategoryID).Error; err != nil {
+		return err
+	}
+
+	if ageCategory.MinimumAge == nil {
+		return ErrAgeCategoryNotRated
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(pin), 10)
+	if err != nil {
+		return err
+	}
+
+	return initializers.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(user).Updates(map[string]interface{}{
+			"parental_age_category_id": ageCategory.ID,
+			"parental_pin":             string(hash),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return lockParentalControls(tx, user.ID)
+	})
+}
+
+// DisableParentalControls removes the restriction and the PIN of the account.
+func DisableParentalControls(user *models.User) error {
+	return initializers.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(user).Updates(map[string]interface{}{
+			"parental_age_category_id": nil,
+			"parental_pin":             "",
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return lockParentalControls(tx, user.ID)
+	})
+}
+
+// CheckParentalPin reports whether the pin is the parental PIN of the user.
+func CheckParentalPin(user *models.User, pin string) bool {
+	if user.ParentalPin == "" {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(user.ParentalPin), []byte(pin)) == nil
+}
+
+// UnlockParentalControls lifts the parental controls for the session until
+// the returned time. Kids profiles stay restricted.
+func UnlockParentalControls(userID, sessionID uint) (time.Time, error) {
+	var session models.Session
+	if err := initializers.DB.Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).First(&session).Error; err != nil {
+		return time.Time{}, ErrSessionNotFound
+	}
+
+	isKids, err := IsKidsProfile(session.ProfileID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if isKids {
+		return time.Time{}, ErrKidsProfile
+	}
+
+	unlockedUntil := time.Now().Add(ParentalUnlockDuration())
+	if err := initializers.DB.Model(&session).Update("parental_unlocked_until", unlockedUntil).Error; err != nil {
+		return time.Time{}, err
+	}
+
+	return unlockedUntil, nil
+}
+
+// IsKidsProfile reports whether the profile is a kids profile. Kids profiles
+// cannot set, lift or disable parental controls.
+func IsKidsProfile(profileID uint) (bool, error) {
+	if profileID == 0 {
+		return false, nil
+	}
+
+	var profile models.Profile
+	if err := initializers.DB.First(&profile, profileID).Error; err != nil {
+		return false, err
+	}
+
+	return profile.IsKids, nil
+}
+
+// LockParentalControls ends an earlier unlock of the session.
+func LockParentalControls(userID, sessionID uint) error {
+	return initializers.DB.Model(&models.Session{}).
+		Where("id = ? AND user_id = ?", sessionID, userID).
+		Update("parental_unlocked_until", nil).Error
+}
+
+func lockParentalControls(tx *gorm.DB, userID uint) error {
+	return tx.Model(&models.Session{}).
+		Where("user_id = ? AND parental_unlocked_until IS NOT NULL", userID).
+		Update("parental_unlocked_until", nil).Error
+}
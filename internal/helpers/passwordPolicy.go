@@ -0,0 +1,104 @@
+package helpers
+
+import (
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+	"github.com/diana-gemini/ozinshe/internal/passwordpolicy"
+	"github.com/diana-gemini/ozinshe/internal/validations"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// CheckPasswordPolicy validates a new password against the password policy.
+// The user is nil on signup; otherwise the password must not repeat one of
+// the user's recent passwords.
+func CheckPasswordPolicy(password, repeatPassword, email string, user *models.User) ([]passwordpolicy.Violation, error) {
+	policy, err := passwordpolicy.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []passwordpolicy.Violation
+
+	if !validations.PasswordRepeat(password, repeatPassword) {
+		violations = append(violations, passwordpolicy.Violation{
+			Code:    passwordpolicy.CodeMismatch,
+			Message: "passwords should be the same",
+		})
+	}
+
+	violations = append(violations, policy.Check(password, email)...)
+
+	if user != nil && policy.HistorySize > 0 {
+		reused, err := isRecentPassword(user, password, policy.HistorySize)
+		if err != nil {
+			return nil, err
+		}
+		if reused {
+			violations = append(violations, passwordpolicy.Violation{
+				Code:    passwordpolicy.CodeReused,
+				Message: "password was used recently",
+			})
+		}
+	}
+
+	return violations, nil
+}
+
+// RecordPassword remembers the new password hash of the user and forgets
+// the ones older than the policy history.
+func RecordPassword(tx *gorm.DB, userID uint, passwordHash string) error {
+	policy, err := passwordpolicy.FromEnv()
+	if err != nil {
+		return err
+	}
+
+	history := models.PasswordHistory{
+		UserID:       userID,
+		PasswordHash: passwordHash,
+	}
+
+	if err := tx.Create(&history).Error; err != nil {
+		return err
+	}
+
+	var keep []uint
+	if err := tx.Model(&models.PasswordHistory{}).
+		Where("user_id = ?", userID).
+		Order("id DESC").
+		Limit(policy.HistorySize).
+		Pluck("id", &keep).Error; err != nil {
+		return err
+	}
+
+	query := tx.Unscoped().Where("user_id = ?", userID)
+	if len(keep) > 0 {
+		query = query.Where("id NOT IN ?", keep)
+	}
+
+	return query.Delete(&models.PasswordHistory{}).Error
+}
+
+func isRecentPassword(user *models.User, password string, historySize int) (bool, error) {
+	var hashes []string
+	if err := initializers.DB.Model(&models.PasswordHistory{}).
+		Where("user_id = ?", user.ID).
+		Order("id DESC").
+		Limit(historySize).
+		Pluck("password_hash", &hashes).Error; err != nil {
+		return false, err
+	}
+
+	if user.Password != "" {
+		hashes = append(hashes, user.Password)
+	}
+
+	for _, hash := range hashes {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
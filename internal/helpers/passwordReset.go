@@ -0,0 +1,82 @@
+package helpers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"gorm.io/gorm"
+)
+
+const defaultPasswordResetTokenTTL = time.Hour
+
+var ErrInvalidResetToken = errors.New("invalid reset token")
+
+// IssuePasswordResetToken stores a hashed reset token for the user and
+// invalidates every token issued to them before.
+func IssuePasswordResetToken(userID uint) (string, error) {
+	token, err := GenerateRandomToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	err = initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().
+			Where("user_id = ? OR expires_at < ?", userID, time.Now()).
+			Delete(&models.PasswordResetToken{}).Error; err != nil {
+			return err
+		}
+
+		resetToken := models.PasswordResetToken{
+			UserID:    userID,
+			TokenHash: HashToken(token),
+			ExpiresAt: time.Now().Add(durationFromEnv("PASSWORD_RESET_TOKEN_TTL", defaultPasswordResetTokenTTL)),
+		}
+
+		return tx.Create(&resetToken).Error
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// FindPasswordResetToken returns the owner of a usable reset token without
+// using it up.
+func FindPasswordResetToken(token string) (uint, error) {
+	var resetToken models.PasswordResetToken
+	err := initializers.DB.Where("token_hash = ? AND used_at IS NULL AND expires_at > ?", HashToken(token), time.Now()).
+		First(&resetToken).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, ErrInvalidResetToken
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return resetToken.UserID, nil
+}
+
+// ConsumePasswordResetToken marks the token as used and returns its owner.
+// It must run in the same transaction as the password update.
+func ConsumePasswordResetToken(tx *gorm.DB, token string) (uint, error) {
+	result := tx.Model(&models.PasswordResetToken{}).
+		Where("token_hash = ? AND used_at IS NULL AND expires_at > ?", HashToken(token), time.Now()).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if result.RowsAffected != 1 {
+		return 0, ErrInvalidResetToken
+	}
+
+	var resetToken models.PasswordResetToken
+	if err := tx.Where("token_hash = ?", HashToken(token)).First(&resetToken).Error; err != nil {
+		return 0, err
+	}
+
+	return resetToken.UserID, nil
+}
@@ -0,0 +1,88 @@
+package helpers
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"gorm.io/gorm"
+)
+
+var ErrProfileNotFound = errors.New("profile not found")
+
+// CreateDefaultProfile gives a new account its first profile, named after the
+// user.
+func CreateDefaultProfile(tx *gorm.DB, user *models.User) error {
+	name := user.Username
+	if name == "" {
+		name, _, _ = strings.Cut(user.Email, "@")
+	}
+
+	profile := models.Profile{
+		UserID: user.ID,
+		Name:   name,
+	}
+
+	return tx.Create(&profile).Error
+}
+
+// SelectProfile switches the session to one of the user's profiles and
+// returns a token pair carrying it. The access token of the request is
+// revoked and the earlier refresh tokens of the session stop working.
+func SelectProfile(userID, sessionID uint, currentJTI string, profileID uint) (*TokenPair, error) {
+	var pair *TokenPair
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		var profile models.Profile
+		if err := tx.Where("id = ? AND user_id = ?", profileID, userID).First(&profile).Error; err != nil {
+			return ErrProfileNotFound
+		}
+
+		var session models.Session
+		if err := tx.Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).First(&session).Error; err != nil {
+			return ErrSessionNotFound
+		}
+
+		// the next viewer may be a kid, so a lifted parental restriction ends
+		session.ProfileID = profile.ID
+		session.ParentalUnlockedUntil = nil
+		err := tx.Model(&session).Updates(map[string]interface{}{
+			"profile_id":              profile.ID,
+			"parental_unlocked_until": nil,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		// deleted rather than revoked, so that a stale refresh token is
+		// rejected without being taken for a stolen one
+		if err := tx.Unscoped().Where("session_id = ?", session.ID).Delete(&models.RefreshToken{}).Error; err != nil {
+			return err
+		}
+
+		if err := revokeAccessToken(tx, currentJTI); err != nil {
+			return err
+		}
+
+		pair, err = issueTokens(tx, &session)
+		return err
+	})
+
+	return pair, err
+}
+
+// defaultProfileID returns the profile a new session starts with: the only
+// profile of the user, or none when the user has to choose.
+func defaultProfileID(tx *gorm.DB, userID uint) (uint, error) {
+	var profileIDs []uint
+	if err := tx.Model(&models.Profile{}).Where("user_id = ?", userID).Limit(2).Pluck("id", &profileIDs).Error; err != nil {
+		return 0, err
+	}
+
+	if len(profileIDs) != 1 {
+		return 0, nil
+	}
+	return profileIDs[0], nil
+}
@@ -0,0 +1,47 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var errInvalidPurposeToken = errors.New("invalid token")
+
+// signPurposeToken signs a short-lived token that is only accepted for the
+// given purpose, so it can never be used as an access token.
+func signPurposeToken(purpose string, ttl time.Duration, claims jwt.MapClaims) (string, error) {
+	claims["purpose"] = purpose
+	claims["exp"] = time.Now().Add(ttl).Unix()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(os.Getenv("SECRET")))
+}
+
+func parsePurposeToken(tokenString, purpose string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+
+		return []byte(os.Getenv("SECRET")), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, errInvalidPurposeToken
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["purpose"] != purpose {
+		return nil, errInvalidPurposeToken
+	}
+
+	return claims, nil
+}
+
+func claimUserID(claims jwt.MapClaims) (uint, bool) {
+	userID, ok := claims["sub"].(float64)
+	return uint(userID), ok
+}
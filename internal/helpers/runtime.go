@@ -0,0 +1,48 @@
+package helpers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	clockRuntimePattern = regexp.MustCompile(`^(\d+):(\d{1,2})$`)
+	hoursPattern        = regexp.MustCompile(`(\d+)\s*(?:h|hr|hrs|hour|hours|ч|сағ)`)
+	minutesPattern      = regexp.MustCompile(`(\d+)\s*(?:m|min|mins|minute|minutes|мин|мин\.)`)
+	numberPattern       = regexp.MustCompile(`\d+`)
+)
+
+// ParseRuntime reads durations like "89", "89 min", "1h 29m" or "1:29" as
+// minutes. It returns 0 when the text holds no duration.
+func ParseRuntime(text string) int {
+	text = strings.ToLower(strings.TrimSpace(text))
+
+	if match := clockRuntimePattern.FindStringSubmatch(text); match != nil {
+		hours, _ := strconv.Atoi(match[1])
+		minutes, _ := strconv.Atoi(match[2])
+		return hours*60 + minutes
+	}
+
+	hours := hoursPattern.FindStringSubmatch(text)
+	minutes := minutesPattern.FindStringSubmatch(text)
+	if hours != nil || minutes != nil {
+		runtime := 0
+		if hours != nil {
+			value, _ := strconv.Atoi(hours[1])
+			runtime += value * 60
+		}
+		if minutes != nil {
+			value, _ := strconv.Atoi(minutes[1])
+			runtime += value
+		}
+		return runtime
+	}
+
+	if match := numberPattern.FindString(text); match != "" {
+		runtime, _ := strconv.Atoi(match)
+		return runtime
+	}
+
+	return 0
+}
@@ -0,0 +1,157 @@
+package helpers
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	maxDeviceNameLength = 100
+	maxUserAgentLength  = 255
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// SessionInfo describes the device a session is used from.
+type SessionInfo struct {
+	DeviceName string
+	UserAgent  string
+	IPAddress  string
+}
+
+// NewSessionInfo reads the device of the request. Clients can name the device
+// with the X-Device-Name header.
+func NewSessionInfo(c *gin.Context) SessionInfo {
+	return SessionInfo{
+		DeviceName: truncate(strings.TrimSpace(c.GetHeader("X-Device-Name")), maxDeviceNameLength),
+		UserAgent:  truncate(c.Request.UserAgent(), maxUserAgentLength),
+		IPAddress:  c.ClientIP(),
+	}
+}
+
+// ListSessions returns the active sessions of the user, most recently used
+// first.
+func ListSessions(userID uint) ([]models.Session, error) {
+	var sessions []models.Session
+
+	err := initializers.DB.
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_seen_at DESC").
+		Find(&sessions).Error
+
+	return sessions, err
+}
+
+// RevokeSession ends one session of the user together with its refresh tokens.
+func RevokeSession(userID, sessionID uint) error {
+	return initializers.DB.Transaction(func(tx *gorm.DB) error {
+		var session models.Session
+		if err := tx.Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).First(&session).Error; err != nil {
+			return ErrSessionNotFound
+		}
+
+		return revokeSessions(tx, "id = ?", session.ID)
+	})
+}
+
+// RevokeOtherSessions ends every session of the user except the current one.
+func RevokeOtherSessions(userID, currentSessionID uint) error {
+	return initializers.DB.Transaction(func(tx *gorm.DB) error {
+		return revokeSessions(tx, "user_id = ? AND id <> ?", userID, currentSessionID)
+	})
+}
+
+func createSession(tx *gorm.DB, userID uint, info SessionInfo) (*models.Session, error) {
+	profileID, err := defaultProfileID(tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	session := models.Session{
+		UserID:     userID,
+		ProfileID:  profileID,
+		DeviceName: info.DeviceName,
+		UserAgent:  info.UserAgent,
+		IPAddress:  info.IPAddress,
+		LastSeenAt: time.Now(),
+		ExpiresAt:  time.Now().Add(refreshTokenTTL()),
+	}
+
+	if err := tx.Create(&session).Error; err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// refreshSession extends the session the refresh token belongs to. Refresh
+// tokens issued before sessions were tracked get a session of their own.
+func refreshSession(tx *gorm.DB, token *models.RefreshToken, info SessionInfo) (*models.Session, error) {
+	if token.SessionID == 0 {
+		return createSession(tx, token.UserID, info)
+	}
+
+	var session models.Session
+	if err := tx.First(&session, token.SessionID).Error; err != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if session.RevokedAt != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	session.UserAgent = info.UserAgent
+	session.IPAddress = info.IPAddress
+	if info.DeviceName != "" {
+		session.DeviceName = info.DeviceName
+	}
+	session.LastSeenAt = time.Now()
+	session.ExpiresAt = time.Now().Add(refreshTokenTTL())
+
+	if err := tx.Save(&session).Error; err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func revokeSessions(tx *gorm.DB, query string, args ...interface{}) error {
+	var sessionIDs []uint
+	if err := tx.Model(&models.Session{}).
+		Where(query, args...).
+		Where("revoked_at IS NULL").
+		Pluck("id", &sessionIDs).Error; err != nil {
+		return err
+	}
+
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	if err := tx.Model(&models.Session{}).
+		Where("id IN ?", sessionIDs).
+		Update("revoked_at", now).Error; err != nil {
+		return err
+	}
+
+	return tx.Model(&models.RefreshToken{}).
+		Where("session_id IN ? AND revoked_at IS NULL", sessionIDs).
+		Update("revoked_at", now).Error
+}
+
+func truncate(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+	return string(runes[:length])
+}
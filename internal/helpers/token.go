@@ -0,0 +1,211 @@
+package helpers
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+var ErrInvalidRefreshToken = errors.New("invalid refresh token")
+
+type TokenPair struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// IssueTokens starts a new session for the user and returns a short-lived
+// access token and a refresh token stored server-side so that the session can
+// be revoked later.
+func IssueTokens(userID uint, info SessionInfo) (*TokenPair, error) {
+	var pair *TokenPair
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		session, err := createSession(tx, userID, info)
+		if err != nil {
+			return err
+		}
+
+		pair, err = issueTokens(tx, session)
+		return err
+	})
+
+	return pair, err
+}
+
+// RotateRefreshToken exchanges a valid refresh token for a new token pair
+// within the same session.
+// A refresh token can be used only once; presenting an already rotated token
+// revokes every session of its owner.
+func RotateRefreshToken(refreshToken string, info SessionInfo) (*TokenPair, error) {
+	var pair *TokenPair
+	var reused *models.RefreshToken
+
+	err := initializers.DB.Transaction(func(tx *gorm.DB) error {
+		var stored models.RefreshToken
+		if err := tx.Where("token_hash = ?", HashToken(refreshToken)).First(&stored).Error; err != nil {
+			return ErrInvalidRefreshToken
+		}
+
+		if stored.RevokedAt != nil {
+			reused = &stored
+			return ErrInvalidRefreshToken
+		}
+
+		if time.Now().After(stored.ExpiresAt) {
+			return ErrInvalidRefreshToken
+		}
+
+		result := tx.Model(&models.RefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", stored.ID).
+			Update("revoked_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrInvalidRefreshToken
+		}
+
+		if err := revokeAccessToken(tx, stored.AccessJTI); err != nil {
+			return err
+		}
+
+		session, err := refreshSession(tx, &stored, info)
+		if err != nil {
+			return err
+		}
+
+		pair, err = issueTokens(tx, session)
+		return err
+	})
+
+	if reused != nil {
+		if err := RevokeUserTokens(reused.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	return pair, err
+}
+
+// RevokeUserTokens ends every session of the user and revokes the refresh
+// tokens issued to them.
+func RevokeUserTokens(userID uint) error {
+	return initializers.DB.Transaction(func(tx *gorm.DB) error {
+		if err := revokeSessions(tx, "user_id = ?", userID); err != nil {
+			return err
+		}
+
+		return tx.Model(&models.RefreshToken{}).
+			Where("user_id = ? AND revoked_at IS NULL", userID).
+			Update("revoked_at", time.Now()).Error
+	})
+}
+
+func GenerateRandomToken(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func issueTokens(tx *gorm.DB, session *models.Session) (*TokenPair, error) {
+	jti, err := GenerateRandomToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{
+		"sub": session.UserID,
+		"sid": session.ID,
+		"jti": jti,
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(accessTokenTTL()).Unix(),
+	}
+	if session.ProfileID != 0 {
+		claims["pid"] = session.ProfileID
+	}
+
+	accessToken, err := initializers.SigningKeys.Sign(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := GenerateRandomToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := models.RefreshToken{
+		UserID:    session.UserID,
+		SessionID: session.ID,
+		TokenHash: HashToken(refreshToken),
+		AccessJTI: jti,
+		ExpiresAt: session.ExpiresAt,
+	}
+
+	if err := tx.Create(&stored).Error; err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func revokeAccessToken(tx *gorm.DB, jti string) error {
+	if err := tx.Unscoped().Where("expires_at < ?", time.Now()).Delete(&models.RevokedToken{}).Error; err != nil {
+		return err
+	}
+
+	var count int64
+	if err := tx.Model(&models.RevokedToken{}).Where("jti = ?", jti).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	revoked := models.RevokedToken{
+		JTI:       jti,
+		ExpiresAt: time.Now().Add(accessTokenTTL()),
+	}
+
+	return tx.Create(&revoked).Error
+}
+
+func accessTokenTTL() time.Duration {
+	return durationFromEnv("ACCESS_TOKEN_TTL", defaultAccessTokenTTL)
+}
+
+func refreshTokenTTL() time.Duration {
+	return durationFromEnv("REFRESH_TOKEN_TTL", defaultRefreshTokenTTL)
+}
+
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,102 @@
+package helpers
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/models"
+	"github.com/diana-gemini/ozinshe/internal/totp"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+const (
+	twoFactorChallengePurpose = "two_factor_challenge"
+	twoFactorChallengeTTL     = 5 * time.Minute
+	recoveryCodesCount        = 10
+)
+
+var ErrInvalidChallengeToken = errors.New("invalid challenge token")
+
+// GenerateTwoFactorChallenge signs the token returned by the first login step
+// of users with two-factor authentication enabled.
+func GenerateTwoFactorChallenge(userID uint) (string, error) {
+	return signPurposeToken(twoFactorChallengePurpose, twoFactorChallengeTTL, jwt.MapClaims{
+		"sub": userID,
+	})
+}
+
+func ParseTwoFactorChallenge(tokenString string) (uint, error) {
+	claims, err := parsePurposeToken(tokenString, twoFactorChallengePurpose)
+	if err != nil {
+		return 0, ErrInvalidChallengeToken
+	}
+
+	userID, ok := claimUserID(claims)
+	if !ok {
+		return 0, ErrInvalidChallengeToken
+	}
+
+	return userID, nil
+}
+
+// VerifyTwoFactorCode accepts either a current TOTP code or an unused recovery
+// code. Every code is accepted only once.
+func VerifyTwoFactorCode(user *models.User, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+
+	if step, ok := totp.Validate(user.TwoFactorSecret, code, time.Now()); ok {
+		result := initializers.DB.Model(&models.User{}).
+			Where("id = ? AND two_factor_last_step < ?", user.ID, step).
+			Update("two_factor_last_step", step)
+		if result.Error != nil {
+			return false, result.Error
+		}
+		return result.RowsAffected == 1, nil
+	}
+
+	result := initializers.DB.Model(&models.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", user.ID, HashToken(normalizeRecoveryCode(code))).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// ReplaceRecoveryCodes drops the old recovery codes of the user and returns
+// new ones. Only their hashes are stored.
+func ReplaceRecoveryCodes(tx *gorm.DB, userID uint) ([]string, error) {
+	if err := tx.Unscoped().Where("user_id = ?", userID).Delete(&models.RecoveryCode{}).Error; err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, recoveryCodesCount)
+	for i := 0; i < recoveryCodesCount; i++ {
+		random, err := GenerateRandomToken(5)
+		if err != nil {
+			return nil, err
+		}
+
+		code := random[:5] + "-" + random[5:]
+		recoveryCode := models.RecoveryCode{
+			UserID:   userID,
+			CodeHash: HashToken(normalizeRecoveryCode(code)),
+		}
+		if err := tx.Create(&recoveryCode).Error; err != nil {
+			return nil, err
+		}
+
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(code, "-", ""))
+}
@@ -0,0 +1,218 @@
+// Package jwtkeys holds the keys used to sign and verify access tokens.
+//
+// Keys are PEM files named <kid>.pem in JWT_KEYS_DIR. The key named by
+// JWT_ACTIVE_KID signs new tokens; every other key only verifies them and is
+// published in the JWKS, so the next key can be announced before it becomes
+// active. Keys listed in JWT_RETIRED_KEYS as <kid>@<RFC 3339 time> stay valid
+// for JWT_KEY_GRACE_PERIOD after that time and are dropped afterwards.
+// Without JWT_KEYS_DIR tokens are signed with HS256 and SECRET as before.
+package jwtkeys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const defaultGracePeriod = 24 * time.Hour
+
+type Key struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.PrivateKey
+	PublicKey  crypto.PublicKey
+	// ExpiresAt is set for retired keys, they are not accepted after it.
+	ExpiresAt *time.Time
+}
+
+type KeySet struct {
+	active     *Key
+	keys       map[string]*Key
+	hmacSecret []byte
+	acceptHMAC bool
+}
+
+// LoadFromEnv reads the key set described in the package documentation.
+func LoadFromEnv() (*KeySet, error) {
+	set := &KeySet{
+		keys:       make(map[string]*Key),
+		hmacSecret: []byte(os.Getenv("SECRET")),
+	}
+
+	dir := os.Getenv("JWT_KEYS_DIR")
+	if dir == "" {
+		set.acceptHMAC = true
+		return set, nil
+	}
+
+	// tokens signed with SECRET before the switch keep working until they expire
+	set.acceptHMAC = os.Getenv("JWT_ACCEPT_HS256") == "true"
+
+	gracePeriod := defaultGracePeriod
+	if value := os.Getenv("JWT_KEY_GRACE_PERIOD"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("JWT_KEY_GRACE_PERIOD: %w", err)
+		}
+		gracePeriod = parsed
+	}
+
+	retired, err := parseRetired(os.Getenv("JWT_RETIRED_KEYS"))
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		kid := strings.TrimSuffix(filepath.Base(file), ".pem")
+
+		key, err := readKey(kid, file)
+		if err != nil {
+			return nil, err
+		}
+
+		if retiredAt, ok := retired[kid]; ok {
+			expiresAt := retiredAt.Add(gracePeriod)
+			key.ExpiresAt = &expiresAt
+		}
+
+		set.keys[kid] = key
+	}
+
+	activeKID := os.Getenv("JWT_ACTIVE_KID")
+	active, ok := set.keys[activeKID]
+	if !ok {
+		return nil, fmt.Errorf("active key %q not found in %s", activeKID, dir)
+	}
+	if active.PrivateKey == nil {
+		return nil, fmt.Errorf("active key %q has no private key", activeKID)
+	}
+	if active.ExpiresAt != nil {
+		return nil, fmt.Errorf("active key %q is retired", activeKID)
+	}
+	set.active = active
+
+	return set, nil
+}
+
+// Sign signs the claims with the active key.
+func (s *KeySet) Sign(claims jwt.MapClaims) (string, error) {
+	if s.active == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.hmacSecret)
+	}
+
+	token := jwt.NewWithClaims(s.active.Method, claims)
+	token.Header["kid"] = s.active.ID
+
+	return token.SignedString(s.active.PrivateKey)
+}
+
+// Keyfunc returns the key that verifies the token, for use with jwt.Parse.
+func (s *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		if !s.acceptHMAC || len(s.hmacSecret) == 0 {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return s.hmacSecret, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key := s.verificationKey(kid)
+	if key == nil {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	return key.PublicKey, nil
+}
+
+// PublicKeys returns the keys that currently verify tokens.
+func (s *KeySet) PublicKeys() []*Key {
+	var keys []*Key
+	for kid := range s.keys {
+		if key := s.verificationKey(kid); key != nil {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (s *KeySet) verificationKey(kid string) *Key {
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil
+	}
+	if key.ExpiresAt != nil && time.Now().After(*key.ExpiresAt) {
+		return nil
+	}
+	return key
+}
+
+func readKey(kid, file string) (*Key, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if private, err := jwt.ParseRSAPrivateKeyFromPEM(data); err == nil {
+		return &Key{ID: kid, Method: jwt.SigningMethodRS256, PrivateKey: private, PublicKey: &private.PublicKey}, nil
+	}
+
+	if private, err := jwt.ParseEdPrivateKeyFromPEM(data); err == nil {
+		if signer, ok := private.(ed25519.PrivateKey); ok {
+			return &Key{ID: kid, Method: jwt.SigningMethodEdDSA, PrivateKey: signer, PublicKey: signer.Public()}, nil
+		}
+	}
+
+	// retired keys may be kept as public keys only
+	if public, err := jwt.ParseRSAPublicKeyFromPEM(data); err == nil {
+		return &Key{ID: kid, Method: jwt.SigningMethodRS256, PublicKey: public}, nil
+	}
+
+	if public, err := jwt.ParseEdPublicKeyFromPEM(data); err == nil {
+		if _, ok := public.(ed25519.PublicKey); ok {
+			return &Key{ID: kid, Method: jwt.SigningMethodEdDSA, PublicKey: public}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: unsupported key, expected an RSA or Ed25519 PEM key", file)
+}
+
+func parseRetired(value string) (map[string]time.Time, error) {
+	retired := make(map[string]time.Time)
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		kid, at, ok := strings.Cut(entry, "@")
+		if !ok {
+			return nil, errors.New("JWT_RETIRED_KEYS entries must look like kid@2006-01-02T15:04:05Z")
+		}
+
+		retiredAt, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			return nil, fmt.Errorf("JWT_RETIRED_KEYS: %w", err)
+		}
+
+		retired[kid] = retiredAt
+	}
+
+	return retired, nil
+}
@@ -0,0 +1,71 @@
+package mailer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogMailer writes messages to a writer instead of sending them.
+type LogMailer struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+func NewLogMailer(writer io.Writer) *LogMailer {
+	return &LogMailer{writer: writer}
+}
+
+func (m *LogMailer) Send(message Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, err := io.WriteString(m.writer, format(message))
+	return err
+}
+
+// FileMailer stores every message as a separate file in a directory.
+type FileMailer struct {
+	dir string
+}
+
+func NewFileMailer(dir string) (*FileMailer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileMailer{dir: dir}, nil
+}
+
+func (m *FileMailer) Send(message Message) error {
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), fileNamePart(message.To))
+	return os.WriteFile(filepath.Join(m.dir, name), []byte(format(message)), 0o644)
+}
+
+// maxFileNamePart keeps the recipient part of a file name short.
+const maxFileNamePart = 100
+
+// fileNamePart replaces the characters of the recipient that are not safe in
+// a file name, so that a message never lands outside the directory.
+func fileNamePart(recipient string) string {
+	part := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' ||
+			r == '@' || r == '.' || r == '_' || r == '+' || r == '-' {
+			return r
+		}
+		return '_'
+	}, recipient)
+
+	if len(part) > maxFileNamePart {
+		part = part[:maxFileNamePart]
+	}
+	return part
+}
+
+func format(message Message) string {
+	return fmt.Sprintf("From: %s\nTo: %s\nSubject: %s\n\n%s\n--- html ---\n%s\n\n",
+		message.From, message.To, message.Subject, message.Text, message.HTML)
+}
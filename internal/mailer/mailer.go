@@ -0,0 +1,74 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"os"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var templatesFS embed.FS
+
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+type Mailer interface {
+	Send(message Message) error
+}
+
+// NewFromEnv builds the mailer selected by MAIL_DRIVER: "smtp", "file" or "log".
+func NewFromEnv() (Mailer, error) {
+	switch driver := os.Getenv("MAIL_DRIVER"); driver {
+	case "smtp":
+		return NewSMTPMailerFromEnv()
+	case "file":
+		dir := os.Getenv("MAIL_DIR")
+		if dir == "" {
+			dir = "tmp/mail"
+		}
+		return NewFileMailer(dir)
+	case "log", "":
+		return NewLogMailer(os.Stdout), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver: %s", driver)
+	}
+}
+
+// NewMessage renders templates/<name>.html and templates/<name>.txt with data.
+func NewMessage(to, subject, name string, data interface{}) (Message, error) {
+	htmlTemplate, err := htmltemplate.ParseFS(templatesFS, "templates/layout.html", "templates/"+name+".html")
+	if err != nil {
+		return Message{}, err
+	}
+
+	var html bytes.Buffer
+	if err := htmlTemplate.ExecuteTemplate(&html, "layout", data); err != nil {
+		return Message{}, err
+	}
+
+	textTemplate, err := texttemplate.ParseFS(templatesFS, "templates/"+name+".txt")
+	if err != nil {
+		return Message{}, err
+	}
+
+	var text bytes.Buffer
+	if err := textTemplate.Execute(&text, data); err != nil {
+		return Message{}, err
+	}
+
+	return Message{
+		From:    os.Getenv("MAIL_FROM"),
+		To:      to,
+		Subject: subject,
+		HTML:    html.String(),
+		Text:    text.String(),
+	}, nil
+}
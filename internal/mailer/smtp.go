@@ -0,0 +1,56 @@
+package mailer
+
+import (
+	"errors"
+	"os"
+	"strconv"
+
+	"gopkg.in/gomail.v2"
+)
+
+type SMTPMailer struct {
+	dialer *gomail.Dialer
+	from   string
+}
+
+func NewSMTPMailer(host string, port int, username, password, from string) *SMTPMailer {
+	return &SMTPMailer{
+		dialer: gomail.NewDialer(host, port, username, password),
+		from:   from,
+	}
+}
+
+func NewSMTPMailerFromEnv() (*SMTPMailer, error) {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		return nil, errors.New("SMTP_HOST is not set")
+	}
+
+	port, err := strconv.Atoi(os.Getenv("SMTP_PORT"))
+	if err != nil {
+		return nil, errors.New("SMTP_PORT is not a number")
+	}
+
+	from := os.Getenv("MAIL_FROM")
+	if from == "" {
+		from = os.Getenv("SMTP_USERNAME")
+	}
+
+	return NewSMTPMailer(host, port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), from), nil
+}
+
+func (m *SMTPMailer) Send(message Message) error {
+	from := message.From
+	if from == "" {
+		from = m.from
+	}
+
+	msg := gomail.NewMessage()
+	msg.SetHeader("From", from)
+	msg.SetHeader("To", message.To)
+	msg.SetHeader("Subject", message.Subject)
+	msg.SetBody("text/plain", message.Text)
+	msg.AddAlternative("text/html", message.HTML)
+
+	return m.dialer.DialAndSend(msg)
+}
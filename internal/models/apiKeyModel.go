@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// APIKey lets a service integration call the API on behalf of the admin who
+// created it, limited to its scopes. Only the hash of the key is stored.
+type APIKey struct {
+	gorm.Model
+	Name       string     `gorm:"not null"`
+	KeyPrefix  string     `gorm:"not null"`
+	KeyHash    string     `gorm:"uniqueIndex;not null"`
+	Scopes     []string   `gorm:"serializer:json;not null"`
+	OwnerID    uint       `gorm:"index;not null"`
+	ExpiresAt  *time.Time `gorm:"index"`
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time `gorm:"index"`
+}
+
+// IsActive reports whether the key can be used right now.
+func (k *APIKey) IsActive() bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || k.ExpiresAt.After(time.Now())
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+)
+
+const (
+	AuditEntityCategory               = "category"
+	AuditEntityType                   = "type"
+	AuditEntityAgeCategory            = "age_category"
+	AuditEntityMovie                  = "movie"
+	AuditEntitySeason                 = "season"
+	AuditEntityKeyword                = "keyword"
+	AuditEntityPerson                 = "person"
+	AuditEntityCredit                 = "credit"
+	AuditEntityEpisode                = "episode"
+	AuditEntityMovieTranslation       = "movie_translation"
+	AuditEntityCategoryTranslation    = "category_translation"
+	AuditEntityTypeTranslation        = "type_translation"
+	AuditEntityAgeCategoryTranslation = "age_category_translation"
+	AuditEntityAPIKey                 = "api_key"
+)
+
+// AuditLog records one admin change of the catalog or the API keys. Rows are
+// only ever inserted; the migration installs a trigger that rejects updates
+// and deletes.
+type AuditLog struct {
+	ID         uint            `json:"id" gorm:"primarykey"`
+	CreatedAt  time.Time       `json:"createdAt" gorm:"index;not null"`
+	ActorID    uint            `json:"actorID" gorm:"index;not null"`
+	APIKeyID   *uint           `json:"apiKeyID"`
+	Action     string          `json:"action" gorm:"not null"`
+	EntityType string          `json:"entityType" gorm:"index:idx_audit_entity;not null"`
+	EntityID   uint            `json:"entityID" gorm:"index:idx_audit_entity;not null"`
+	Before     json.RawMessage `json:"before" gorm:"type:jsonb" swaggertype:"object"`
+	After      json.RawMessage `json:"after" gorm:"type:jsonb" swaggertype:"object"`
+	IPAddress  string          `json:"ipAddress"`
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -12,14 +14,25 @@ type Movie struct {
 	AgeCategoryID uint
 	Screenshots   []Screenshot
 	Seasons       []Season
-	Year          string `gorm:"not null" json:"year"`
-	Timing        string `gorm:"not null" json:"timing"`
-	Keywords      string `gorm:"not null" json:"keywords"`
-	Description   string `gorm:"not null" json:"description"`
-	Director      string `gorm:"not null" json:"director"`
-	Producer      string `gorm:"not null" json:"producer"`
-	Cover         string `gorm:"not null" json:"cover"`
-	CountOfWatch  int    `json:"countOfWatch"`
+	Year          int       `gorm:"not null;default:0;index" json:"year"`
+	Runtime       int       `gorm:"not null;default:0" json:"runtime"` // minutes
+	Keywords      []Keyword `gorm:"many2many:movie_keywords;" json:"keywords"`
+	Description   string    `gorm:"not null" json:"description"`
+	Credits       []Credit  `json:"credits,omitempty"`
+	Cover         string    `gorm:"not null" json:"cover"`
+	CountOfWatch  int       `json:"countOfWatch"`
+
+	// not stored; the catalog responses fill them in the locale of the request
+	TypeName        string `gorm:"-" json:"typeName,omitempty"`
+	AgeCategoryName string `gorm:"-" json:"ageCategoryName,omitempty"`
+}
+
+// Keyword is a normalised tag: trimmed, lower case, with single spaces. It is
+// deleted for good, so that the name can be used again.
+type Keyword struct {
+	gorm.Model
+	Name   string  `gorm:"uniqueIndex;not null"`
+	Movies []Movie `gorm:"many2many:movie_keywords;"`
 }
 
 type Category struct {
@@ -37,7 +50,10 @@ type Type struct {
 type AgeCategory struct {
 	gorm.Model
 	AgeCategoryName string
-	Movies          []Movie
+	// MinimumAge orders the age categories for parental controls. Movies of
+	// a category without one are hidden from restricted accounts.
+	MinimumAge *int
+	Movies     []Movie
 }
 
 type Screenshot struct {
@@ -46,14 +62,29 @@ type Screenshot struct {
 	MovieID uint
 }
 
+// Season is addressed by its Number, which is unique within the movie and
+// stays the same when other seasons are deleted.
 type Season struct {
 	gorm.Model
-	Videos  []Video
-	MovieID uint
+	Videos      []Video
+	MovieID     uint   `gorm:"uniqueIndex:idx_seasons_movie_number"`
+	Number      int    `gorm:"not null;default:0;uniqueIndex:idx_seasons_movie_number" json:"number"`
+	Title       string `json:"title"`
+	Year        int    `gorm:"not null;default:0" json:"year"`
+	Description string `json:"description"`
 }
 
+// Video is an episode of a season. EpisodeNumber is unique within the season
+// and Position orders the episodes for display.
 type Video struct {
 	gorm.Model
-	Link     string
-	SeasonID uint
+	Link          string
+	SeasonID      uint       `gorm:"uniqueIndex:idx_videos_season_episode"`
+	EpisodeNumber int        `gorm:"not null;default:0;uniqueIndex:idx_videos_season_episode" json:"episodeNumber"`
+	Position      int        `gorm:"not null;default:0" json:"position"`
+	Title         string     `json:"title"`
+	Description   string     `json:"description"`
+	Duration      int        `gorm:"not null;default:0" json:"duration"` // minutes
+	Thumbnail     string     `json:"thumbnail"`
+	ReleaseDate   *time.Time `gorm:"type:date" json:"releaseDate"`
 }
@@ -0,0 +1,31 @@
+package models
+
+import "gorm.io/gorm"
+
+const (
+	CreditRoleDirector = "director"
+	CreditRoleWriter   = "writer"
+	CreditRoleProducer = "producer"
+	CreditRoleActor    = "actor"
+)
+
+// Person is someone in the cast or crew of movies.
+type Person struct {
+	gorm.Model
+	Name    string   `gorm:"not null;index" json:"name"`
+	Photo   string   `json:"photo"`
+	Bio     string   `json:"bio"`
+	Credits []Credit `json:"credits,omitempty"`
+}
+
+// Credit links a person to a movie in one role. Character is only set for
+// actors; Position orders the credits of a movie.
+type Credit struct {
+	gorm.Model
+	MovieID   uint    `gorm:"index;not null" json:"movieID"`
+	PersonID  uint    `gorm:"index;not null" json:"personID"`
+	Role      string  `gorm:"not null" json:"role"`
+	Character string  `json:"character"`
+	Position  int     `gorm:"not null;default:0" json:"position"`
+	Person    *Person `json:"person,omitempty"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "gorm.io/gorm"
+
+// MaxProfilesPerUser limits how many viewer profiles one account can have.
+const MaxProfilesPerUser = 5
+
+// Profile is one viewer of an account. Favorites and other per-viewer state
+// belong to a profile rather than to the user.
+type Profile struct {
+	gorm.Model
+	UserID   uint   `json:"userID" gorm:"index;not null"`
+	Name     string `json:"name" gorm:"not null"`
+	Avatar   string `json:"avatar"`
+	IsKids   bool   `json:"isKids"`
+	Language string `json:"language"`
+}
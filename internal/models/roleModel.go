@@ -0,0 +1,42 @@
+package models
+
+import "gorm.io/gorm"
+
+const (
+	PermissionCatalogRead  = "catalog:read"
+	PermissionCatalogWrite = "catalog:write"
+	PermissionMovieWrite   = "movie:write"
+	PermissionUserManage   = "user:manage"
+	PermissionAuditRead    = "audit:read"
+	PermissionAPIKeyManage = "apikey:manage"
+)
+
+const (
+	RoleAdmin         = "admin"
+	RoleUser          = "user"
+	RoleContentEditor = "content_editor"
+	RoleModerator     = "moderator"
+)
+
+type Role struct {
+	gorm.Model
+	Name        string       `json:"name" gorm:"unique;not null"`
+	Permissions []Permission `json:"permissions" gorm:"many2many:role_permissions;"`
+}
+
+type Permission struct {
+	gorm.Model
+	Name string `json:"name" gorm:"unique;not null"`
+}
+
+// DefaultRoles lists the seeded roles with their permissions. Admin and user
+// come first so that a fresh database keeps the IDs 1 and 2 they had before.
+var DefaultRoles = []struct {
+	Name        string
+	Permissions []string
+}{
+	{RoleAdmin, []string{PermissionCatalogRead, PermissionCatalogWrite, PermissionMovieWrite, PermissionUserManage, PermissionAuditRead, PermissionAPIKeyManage}},
+	{RoleUser, []string{PermissionCatalogRead}},
+	{RoleContentEditor, []string{PermissionCatalogRead, PermissionCatalogWrite, PermissionMovieWrite}},
+	{RoleModerator, []string{PermissionCatalogRead, PermissionMovieWrite}},
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Session struct {
+	gorm.Model
+	UserID     uint `gorm:"index;not null"`
+	ProfileID  uint
+	DeviceName string
+	UserAgent  string
+	IPAddress  string
+	LastSeenAt time.Time  `gorm:"not null"`
+	ExpiresAt  time.Time  `gorm:"index;not null"`
+	RevokedAt  *time.Time `gorm:"index"`
+	// ParentalUnlockedUntil lifts the parental controls of the account for
+	// this session after the PIN was entered.
+	ParentalUnlockedUntil *time.Time
+}
+
+type RefreshToken struct {
+	gorm.Model
+	UserID    uint       `gorm:"index;not null"`
+	SessionID uint       `gorm:"index"`
+	TokenHash string     `gorm:"uniqueIndex;not null"`
+	AccessJTI string     `gorm:"index;not null"`
+	ExpiresAt time.Time  `gorm:"not null"`
+	RevokedAt *time.Time `gorm:"index"`
+}
+
+type RevokedToken struct {
+	gorm.Model
+	JTI       string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"index;not null"`
+}
+
+type PasswordResetToken struct {
+	gorm.Model
+	UserID    uint      `gorm:"index;not null"`
+	TokenHash string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"index;not null"`
+	UsedAt    *time.Time
+}
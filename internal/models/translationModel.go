@@ -0,0 +1,45 @@
+package models
+
+import "gorm.io/gorm"
+
+// Locales of the catalog content.
+const (
+	LocaleKazakh  = "kk"
+	LocaleRussian = "ru"
+	LocaleEnglish = "en"
+)
+
+var Locales = []string{LocaleKazakh, LocaleRussian, LocaleEnglish}
+
+// The translations hold the text of a catalog entry in one locale. An empty
+// field falls back to the next locale of the request, and in the end to the
+// untranslated text of the entry. Translations are deleted for good.
+
+type MovieTranslation struct {
+	gorm.Model
+	MovieID       uint   `gorm:"not null;uniqueIndex:idx_movie_translations_movie_locale"`
+	Locale        string `gorm:"not null;uniqueIndex:idx_movie_translations_movie_locale"`
+	NameOfProject string
+	Description   string
+}
+
+type CategoryTranslation struct {
+	gorm.Model
+	CategoryID   uint   `gorm:"not null;uniqueIndex:idx_category_translations_category_locale"`
+	Locale       string `gorm:"not null;uniqueIndex:idx_category_translations_category_locale"`
+	CategoryName string
+}
+
+type TypeTranslation struct {
+	gorm.Model
+	TypeID   uint   `gorm:"not null;uniqueIndex:idx_type_translations_type_locale"`
+	Locale   string `gorm:"not null;uniqueIndex:idx_type_translations_type_locale"`
+	TypeName string
+}
+
+type AgeCategoryTranslation struct {
+	gorm.Model
+	AgeCategoryID   uint   `gorm:"not null;uniqueIndex:idx_age_category_translations_age_category_locale"`
+	Locale          string `gorm:"not null;uniqueIndex:idx_age_category_translations_age_category_locale"`
+	AgeCategoryName string
+}
@@ -1,20 +1,95 @@
 package models
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type User struct {
 	gorm.Model
-	Email       string     `json:"email" gorm:"unique;not null"`
-	Password    string     `json:"-"`
-	RoleID      uint       `json:"roleID"`
-	Username    string     `json:"username"`
-	MobilePhone string     `json:"mobilephone"`
-	BirthDate   string     `json:"birthdate"`
-	Favorites   []Favorite `json:"favorites"`
+	Email               string     `json:"email" gorm:"unique;not null"`
+	Password            string     `json:"-"`
+	RoleID              uint       `json:"roleID"`
+	Username            string     `json:"username"`
+	MobilePhone         string     `json:"mobilephone"`
+	BirthDate           string     `json:"birthdate"`
+	EmailVerifiedAt     *time.Time `json:"emailVerifiedAt"`
+	VerificationSentAt  *time.Time `json:"-"`
+	TwoFactorSecret     string     `json:"-"`
+	TwoFactorEnabledAt  *time.Time `json:"-"`
+	TwoFactorLastStep   int64      `json:"-"`
+	DeletionScheduledAt *time.Time `json:"-"`
+	SuspendedAt         *time.Time `json:"-"`
+	SuspendedUntil      *time.Time `json:"-"`
+	SuspensionReason    string     `json:"-"`
+	// ParentalAgeCategoryID is the highest age rating the account may watch
+	// without the parental PIN; nil when parental controls are off.
+	ParentalAgeCategoryID *uint      `json:"-"`
+	ParentalPin           string     `json:"-"`
+	Favorites             []Favorite `json:"favorites"`
+}
+
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+func (u *User) IsTwoFactorEnabled() bool {
+	return u.TwoFactorEnabledAt != nil
+}
+
+func (u *User) IsDeletionScheduled() bool {
+	return u.DeletionScheduledAt != nil
+}
+
+// IsSuspended reports whether the account is suspended right now. A
+// suspension without an end date is a ban.
+func (u *User) IsSuspended() bool {
+	if u.SuspendedAt == nil {
+		return false
+	}
+	return u.SuspendedUntil == nil || u.SuspendedUntil.After(time.Now())
 }
 
 type Favorite struct {
 	gorm.Model
-	MovieID uint
-	UserID  uint
+	MovieID   uint
+	UserID    uint
+	ProfileID uint `gorm:"index"`
+}
+
+type PasswordHistory struct {
+	gorm.Model
+	UserID       uint   `gorm:"index;not null"`
+	PasswordHash string `gorm:"not null"`
+}
+
+type LoginThrottle struct {
+	gorm.Model
+	Key          string    `gorm:"uniqueIndex;not null"`
+	Failures     int       `gorm:"not null"`
+	LastFailedAt time.Time `gorm:"not null"`
+	LockedUntil  *time.Time
+}
+
+type RecoveryCode struct {
+	gorm.Model
+	UserID   uint   `gorm:"index;not null"`
+	CodeHash string `gorm:"not null"`
+	UsedAt   *time.Time
+}
+
+type UserIdentity struct {
+	gorm.Model
+	UserID   uint   `gorm:"index;not null"`
+	Provider string `gorm:"uniqueIndex:idx_provider_subject;not null"`
+	Subject  string `gorm:"uniqueIndex:idx_provider_subject;not null"`
+}
+
+type OAuthState struct {
+	gorm.Model
+	State        string    `gorm:"uniqueIndex;not null"`
+	Nonce        string    `gorm:"not null"`
+	CodeVerifier string    `gorm:"not null"`
+	ExpiresAt    time.Time `gorm:"index;not null"`
 }
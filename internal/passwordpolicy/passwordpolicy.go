@@ -0,0 +1,187 @@
+package passwordpolicy
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	CodeMismatch         = "mismatch"
+	CodeTooShort         = "too_short"
+	CodeTooLong          = "too_long"
+	CodeMissingUppercase = "missing_uppercase"
+	CodeMissingLowercase = "missing_lowercase"
+	CodeMissingDigit     = "missing_digit"
+	CodeMissingSymbol    = "missing_symbol"
+	CodeContainsEmail    = "contains_email"
+	CodeBreached         = "breached"
+	CodeReused           = "reused"
+)
+
+//go:embed common.txt
+var commonFS embed.FS
+
+// Violation is one rule a password does not satisfy.
+type Violation struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type Policy struct {
+	MinLength        int
+	MaxLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	// HistorySize is how many previous passwords cannot be reused.
+	HistorySize int
+
+	breached map[string]struct{}
+}
+
+var (
+	loadOnce sync.Once
+	loaded   *Policy
+	loadErr  error
+)
+
+// FromEnv returns the policy configured with the PASSWORD_* variables. The
+// breached list is the embedded list of common passwords plus the lines of
+// PASSWORD_BREACHED_LIST_FILE when it is set.
+func FromEnv() (*Policy, error) {
+	loadOnce.Do(func() {
+		loaded, loadErr = fromEnv()
+	})
+	return loaded, loadErr
+}
+
+func fromEnv() (*Policy, error) {
+	policy := &Policy{
+		MinLength:        intFromEnv("PASSWORD_MIN_LENGTH", 8),
+		MaxLength:        intFromEnv("PASSWORD_MAX_LENGTH", 50),
+		RequireUppercase: os.Getenv("PASSWORD_REQUIRE_UPPERCASE") == "true",
+		RequireLowercase: os.Getenv("PASSWORD_REQUIRE_LOWERCASE") == "true",
+		RequireDigit:     os.Getenv("PASSWORD_REQUIRE_DIGIT") == "true",
+		RequireSymbol:    os.Getenv("PASSWORD_REQUIRE_SYMBOL") == "true",
+		HistorySize:      intFromEnv("PASSWORD_HISTORY_SIZE", 5),
+		breached:         map[string]struct{}{},
+	}
+
+	common, err := commonFS.Open("common.txt")
+	if err != nil {
+		return nil, err
+	}
+	defer common.Close()
+
+	if err := policy.addBreached(common); err != nil {
+		return nil, err
+	}
+
+	if path := os.Getenv("PASSWORD_BREACHED_LIST_FILE"); path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("open breached password list: %w", err)
+		}
+		defer file.Close()
+
+		if err := policy.addBreached(file); err != nil {
+			return nil, err
+		}
+	}
+
+	return policy, nil
+}
+
+// Check validates the password on its own and against the email of the
+// account. Password reuse depends on stored hashes and is checked by the caller.
+func (p *Policy) Check(password, email string) []Violation {
+	var violations []Violation
+
+	length := len([]rune(password))
+	if length < p.MinLength {
+		violations = append(violations, Violation{CodeTooShort, fmt.Sprintf("password must be at least %d characters long", p.MinLength)})
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, Violation{CodeTooLong, fmt.Sprintf("password must be at most %d characters long", p.MaxLength)})
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+
+	if p.RequireUppercase && !hasUpper {
+		violations = append(violations, Violation{CodeMissingUppercase, "password must contain an uppercase letter"})
+	}
+	if p.RequireLowercase && !hasLower {
+		violations = append(violations, Violation{CodeMissingLowercase, "password must contain a lowercase letter"})
+	}
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, Violation{CodeMissingDigit, "password must contain a digit"})
+	}
+	if p.RequireSymbol && !hasSymbol {
+		violations = append(violations, Violation{CodeMissingSymbol, "password must contain a symbol"})
+	}
+
+	if containsEmail(password, email) {
+		violations = append(violations, Violation{CodeContainsEmail, "password must not contain the email"})
+	}
+
+	if _, ok := p.breached[strings.ToLower(password)]; ok {
+		violations = append(violations, Violation{CodeBreached, "password is too common"})
+	}
+
+	return violations
+}
+
+func (p *Policy) addBreached(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			p.breached[strings.ToLower(line)] = struct{}{}
+		}
+	}
+	return scanner.Err()
+}
+
+// containsEmail reports whether the password contains the email or its local
+// part. Local parts shorter than three characters are ignored.
+func containsEmail(password, email string) bool {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return false
+	}
+
+	password = strings.ToLower(password)
+	if strings.Contains(password, email) {
+		return true
+	}
+
+	local, _, _ := strings.Cut(email, "@")
+	return len(local) >= 3 && strings.Contains(password, local)
+}
+
+func intFromEnv(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value < 0 {
+		return fallback
+	}
+	return value
+}
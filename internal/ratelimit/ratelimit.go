@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// Limit describes a token bucket: Burst tokens at most, refilled at
+// PerMinute tokens per minute.
+type Limit struct {
+	PerMinute int
+	Burst     int
+}
+
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	ResetAfter time.Duration
+	RetryAfter time.Duration
+}
+
+// Store keeps the buckets. The in-memory store works for a single instance;
+// a shared store can implement the same interface.
+type Store interface {
+	Take(key string, limit Limit) (Result, error)
+}
+
+// LimitFromEnv reads <prefix>_PER_MINUTE and <prefix>_BURST, falling back to
+// the given limit for missing or invalid values.
+func LimitFromEnv(prefix string, fallback Limit) Limit {
+	limit := fallback
+
+	if value, err := strconv.Atoi(os.Getenv(prefix + "_PER_MINUTE")); err == nil && value > 0 {
+		limit.PerMinute = value
+	}
+
+	if value, err := strconv.Atoi(os.Getenv(prefix + "_BURST")); err == nil && value > 0 {
+		limit.Burst = value
+	}
+
+	return limit
+}
+
+func (l Limit) interval() time.Duration {
+	return time.Minute / time.Duration(l.PerMinute)
+}
@@ -1,8 +1,12 @@
 package validations
 
 import (
+	"errors"
 	"fmt"
+
 	"github.com/diana-gemini/ozinshe/db/initializers"
+
+	"gorm.io/gorm"
 )
 
 func IsUniqueValue(tableName, fieldName, value string) bool {
@@ -18,22 +22,6 @@ func IsUniqueValue(tableName, fieldName, value string) bool {
 	return count > 0
 }
 
-func CheckPassword(password, passwordrepeat string) bool {
-	if password == "" {
-		return false
-	}
-	if len(password) < 8 {
-		return false
-	}
-	if len(password) > 50 {
-		return false
-	}
-	if PasswordRepeat(password, passwordrepeat) {
-		return true
-	}
-	return false
-}
-
 func PasswordRepeat(password, passwordrepeat string) bool {
 	if passwordrepeat == "" {
 		return false
@@ -59,6 +47,15 @@ func IsUniqueTwoValue(tableName, firstfieldName, secondfieldName string, firstVa
 	return count > 0
 }
 
+// IsDuplicateKey reports whether err is a violation of a unique index.
+func IsDuplicateKey(err error) bool {
+	if translator, ok := initializers.DB.Dialector.(gorm.ErrorTranslator); ok {
+		err = translator.Translate(err)
+	}
+
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
+
 func IsExistValue(tableName, fieldName string, value interface{}) bool {
 	var count int64
 
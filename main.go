@@ -1,9 +1,12 @@
 package main
 
 import (
+	"time"
+
 	"github.com/diana-gemini/ozinshe/api/router"
 	"github.com/diana-gemini/ozinshe/config"
 	"github.com/diana-gemini/ozinshe/db/initializers"
+	"github.com/diana-gemini/ozinshe/internal/helpers"
 
 	_ "github.com/diana-gemini/ozinshe/docs"
 	"github.com/gin-gonic/gin"
@@ -25,11 +28,14 @@ import (
 func init() {
 	config.LoadEnvVariables()
 	initializers.ConnectDB()
+	initializers.ConnectMailer()
+	initializers.LoadSigningKeys()
 }
 
 func main() {
 	r := gin.Default()
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	router.GetRoute(r)
+	helpers.StartAccountPurge(time.Hour)
 	r.Run()
 }